@@ -57,10 +57,10 @@ func (m *AddBookRequest) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 32 {
+	if utf8.RuneCountInString(m.GetTitle()) > 32 {
 		err := AddBookRequestValidationError{
 			field:  "Title",
-			reason: "value length must be between 1 and 32 runes, inclusive",
+			reason: "value length must be at most 32 runes",
 		}
 		if !all {
 			return err
@@ -79,6 +79,104 @@ func (m *AddBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetAuthorNames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := AddBookRequestValidationError{
+				field:  fmt.Sprintf("AuthorNames[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn()) > 17 {
+		err := AddBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddBookRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := AddBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEdition()) > 50 {
+		err := AddBookRequestValidationError{
+			field:  "Edition",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enrich
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 200 {
+		err := AddBookRequestValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCoverUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetCoverUrl()) > 2048 {
+			err := AddBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetCoverUrl()); err != nil {
+			err = AddBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AddBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AddBookRequestMultiError(errors)
 	}
@@ -157,6 +255,8 @@ var _ interface {
 	ErrorName() string
 } = AddBookRequestValidationError{}
 
+var _AddBookRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
 // Validate checks the field values on AddBookResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -517,44 +617,64 @@ var _ interface {
 	ErrorName() string
 } = GetBookResponseValidationError{}
 
-// Validate checks the field values on DeleteBookRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookRequest) Validate() error {
+// Validate checks the field values on GetBookByIsbnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookByIsbnRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookRequest with the rules
+// ValidateAll checks the field values on GetBookByIsbnRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBookRequestMultiError, or nil if none found.
-func (m *DeleteBookRequest) ValidateAll() error {
+// GetBookByIsbnRequestMultiError, or nil if none found.
+func (m *GetBookByIsbnRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookRequest) validate(all bool) error {
+func (m *GetBookByIsbnRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if l := utf8.RuneCountInString(m.GetIsbn()); l < 10 || l > 17 {
+		err := GetBookByIsbnRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be between 10 and 17 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetBookByIsbnRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := GetBookByIsbnRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteBookRequestMultiError(errors)
+		return GetBookByIsbnRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteBookRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteBookRequestMultiError []error
+// GetBookByIsbnRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookByIsbnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookByIsbnRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookRequestMultiError) Error() string {
+func (m GetBookByIsbnRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -563,11 +683,11 @@ func (m DeleteBookRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookRequestMultiError) AllErrors() []error { return m }
+func (m GetBookByIsbnRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookRequestValidationError is the validation error returned by
-// DeleteBookRequest.Validate if the designated constraints aren't met.
-type DeleteBookRequestValidationError struct {
+// GetBookByIsbnRequestValidationError is the validation error returned by
+// GetBookByIsbnRequest.Validate if the designated constraints aren't met.
+type GetBookByIsbnRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -575,24 +695,24 @@ type DeleteBookRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookRequestValidationError) Field() string { return e.field }
+func (e GetBookByIsbnRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookRequestValidationError) Reason() string { return e.reason }
+func (e GetBookByIsbnRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookRequestValidationError) Cause() error { return e.cause }
+func (e GetBookByIsbnRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookRequestValidationError) Key() bool { return e.key }
+func (e GetBookByIsbnRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookRequestValidationError) ErrorName() string {
-	return "DeleteBookRequestValidationError"
+func (e GetBookByIsbnRequestValidationError) ErrorName() string {
+	return "GetBookByIsbnRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookRequestValidationError) Error() string {
+func (e GetBookByIsbnRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -604,14 +724,14 @@ func (e DeleteBookRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookRequest.%s: %s%s",
+		"invalid %sGetBookByIsbnRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookRequestValidationError{}
+var _ error = GetBookByIsbnRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -619,44 +739,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookRequestValidationError{}
+} = GetBookByIsbnRequestValidationError{}
 
-// Validate checks the field values on DeleteBookResponse with the rules
+var _GetBookByIsbnRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
+// Validate checks the field values on GetBookByIsbnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookResponse) Validate() error {
+func (m *GetBookByIsbnResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookResponse with the rules
+// ValidateAll checks the field values on GetBookByIsbnResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBookResponseMultiError, or nil if none found.
-func (m *DeleteBookResponse) ValidateAll() error {
+// GetBookByIsbnResponseMultiError, or nil if none found.
+func (m *GetBookByIsbnResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookResponse) validate(all bool) error {
+func (m *GetBookByIsbnResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookByIsbnResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookByIsbnResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookByIsbnResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteBookResponseMultiError(errors)
+		return GetBookByIsbnResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteBookResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteBookResponseMultiError []error
+// GetBookByIsbnResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookByIsbnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookByIsbnResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookResponseMultiError) Error() string {
+func (m GetBookByIsbnResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -665,11 +816,11 @@ func (m DeleteBookResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookResponseMultiError) AllErrors() []error { return m }
+func (m GetBookByIsbnResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBookResponseValidationError is the validation error returned by
-// DeleteBookResponse.Validate if the designated constraints aren't met.
-type DeleteBookResponseValidationError struct {
+// GetBookByIsbnResponseValidationError is the validation error returned by
+// GetBookByIsbnResponse.Validate if the designated constraints aren't met.
+type GetBookByIsbnResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -677,24 +828,24 @@ type DeleteBookResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookResponseValidationError) Field() string { return e.field }
+func (e GetBookByIsbnResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookResponseValidationError) Reason() string { return e.reason }
+func (e GetBookByIsbnResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookResponseValidationError) Cause() error { return e.cause }
+func (e GetBookByIsbnResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookResponseValidationError) Key() bool { return e.key }
+func (e GetBookByIsbnResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookResponseValidationError) ErrorName() string {
-	return "DeleteBookResponseValidationError"
+func (e GetBookByIsbnResponseValidationError) ErrorName() string {
+	return "GetBookByIsbnResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookResponseValidationError) Error() string {
+func (e GetBookByIsbnResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -706,14 +857,14 @@ func (e DeleteBookResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookResponse.%s: %s%s",
+		"invalid %sGetBookByIsbnResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookResponseValidationError{}
+var _ error = GetBookByIsbnResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -721,36 +872,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookResponseValidationError{}
+} = GetBookByIsbnResponseValidationError{}
 
-// Validate checks the field values on UpdateBookRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookRequest) Validate() error {
+// Validate checks the field values on ImportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookRequestMultiError, or nil if none found.
-func (m *UpdateBookRequest) ValidateAll() error {
+// ValidateAll checks the field values on ImportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportOptionsMultiError, or
+// nil if none found.
+func (m *ImportOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookRequest) validate(all bool) error {
+func (m *ImportOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if _, ok := _ImportOptions_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportOptionsValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv jsonl]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 32 {
-		err := UpdateBookRequestValidationError{
-			field:  "Title",
-			reason: "value length must be between 1 and 32 runes, inclusive",
+	{
+		sorted_keys := make([]string, len(m.GetColumns()))
+		i := 0
+		for key := range m.GetColumns() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetColumns()[key]
+			_ = val
+
+			if _, ok := _ImportOptions_Columns_InLookup[key]; !ok {
+				err := ImportOptionsValidationError{
+					field:  fmt.Sprintf("Columns[%v]", key),
+					reason: "value must be in list [title year isbn edition publisher cover_url authors]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) < 1 {
+				err := ImportOptionsValidationError{
+					field:  fmt.Sprintf("Columns[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetAuthorsSeparator()) > 1 {
+		err := ImportOptionsValidationError{
+			field:  "AuthorsSeparator",
+			reason: "value length must be at most 1 runes",
 		}
 		if !all {
 			return err
@@ -758,10 +955,12 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetYear(); val < 0 || val > 9999 {
-		err := UpdateBookRequestValidationError{
-			field:  "Year",
-			reason: "value must be inside range [0, 9999]",
+	// no validation rules for DryRun
+
+	if val := m.GetBatchSize(); val < 0 || val > 1000 {
+		err := ImportOptionsValidationError{
+			field:  "BatchSize",
+			reason: "value must be inside range [0, 1000]",
 		}
 		if !all {
 			return err
@@ -770,19 +969,19 @@ func (m *UpdateBookRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateBookRequestMultiError(errors)
+		return ImportOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateBookRequest.ValidateAll() if the designated constraints
+// ImportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ImportOptions.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateBookRequestMultiError []error
+type ImportOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookRequestMultiError) Error() string {
+func (m ImportOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -791,11 +990,11 @@ func (m UpdateBookRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookRequestMultiError) AllErrors() []error { return m }
+func (m ImportOptionsMultiError) AllErrors() []error { return m }
 
-// UpdateBookRequestValidationError is the validation error returned by
-// UpdateBookRequest.Validate if the designated constraints aren't met.
-type UpdateBookRequestValidationError struct {
+// ImportOptionsValidationError is the validation error returned by
+// ImportOptions.Validate if the designated constraints aren't met.
+type ImportOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -803,24 +1002,22 @@ type UpdateBookRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookRequestValidationError) Field() string { return e.field }
+func (e ImportOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookRequestValidationError) Reason() string { return e.reason }
+func (e ImportOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookRequestValidationError) Cause() error { return e.cause }
+func (e ImportOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookRequestValidationError) Key() bool { return e.key }
+func (e ImportOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookRequestValidationError) ErrorName() string {
-	return "UpdateBookRequestValidationError"
-}
+func (e ImportOptionsValidationError) ErrorName() string { return "ImportOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookRequestValidationError) Error() string {
+func (e ImportOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -832,14 +1029,14 @@ func (e UpdateBookRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookRequest.%s: %s%s",
+		"invalid %sImportOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookRequestValidationError{}
+var _ error = ImportOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -847,57 +1044,143 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookRequestValidationError{}
+} = ImportOptionsValidationError{}
 
-// Validate checks the field values on UpdateBookResponse with the rules
+var _ImportOptions_Format_InLookup = map[string]struct{}{
+	"csv":   {},
+	"jsonl": {},
+}
+
+var _ImportOptions_Columns_InLookup = map[string]struct{}{
+	"title":     {},
+	"year":      {},
+	"isbn":      {},
+	"edition":   {},
+	"publisher": {},
+	"cover_url": {},
+	"authors":   {},
+}
+
+// Validate checks the field values on ImportBooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookResponse) Validate() error {
+func (m *ImportBooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookResponse with the rules
+// ValidateAll checks the field values on ImportBooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookResponseMultiError, or nil if none found.
-func (m *UpdateBookResponse) ValidateAll() error {
+// ImportBooksRequestMultiError, or nil if none found.
+func (m *ImportBooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookResponse) validate(all bool) error {
+func (m *ImportBooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return UpdateBookResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// UpdateBookResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateBookResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateBookResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m UpdateBookResponseMultiError) AllErrors() []error { return m }
+	oneofPayloadPresent := false
+	switch v := m.Payload.(type) {
+	case *ImportBooksRequest_Options:
+		if v == nil {
+			err := ImportBooksRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
 
-// UpdateBookResponseValidationError is the validation error returned by
-// UpdateBookResponse.Validate if the designated constraints aren't met.
-type UpdateBookResponseValidationError struct {
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBooksRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBooksRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBooksRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportBooksRequest_Chunk:
+		if v == nil {
+			err := ImportBooksRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofPayloadPresent {
+		err := ImportBooksRequestValidationError{
+			field:  "Payload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBooksRequestMultiError) AllErrors() []error { return m }
+
+// ImportBooksRequestValidationError is the validation error returned by
+// ImportBooksRequest.Validate if the designated constraints aren't met.
+type ImportBooksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -905,24 +1188,24 @@ type UpdateBookResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookResponseValidationError) Field() string { return e.field }
+func (e ImportBooksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookResponseValidationError) Reason() string { return e.reason }
+func (e ImportBooksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookResponseValidationError) Cause() error { return e.cause }
+func (e ImportBooksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookResponseValidationError) Key() bool { return e.key }
+func (e ImportBooksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookResponseValidationError) ErrorName() string {
-	return "UpdateBookResponseValidationError"
+func (e ImportBooksRequestValidationError) ErrorName() string {
+	return "ImportBooksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookResponseValidationError) Error() string {
+func (e ImportBooksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -934,14 +1217,14 @@ func (e UpdateBookResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookResponse.%s: %s%s",
+		"invalid %sImportBooksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookResponseValidationError{}
+var _ error = ImportBooksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -949,44 +1232,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookResponseValidationError{}
+} = ImportBooksRequestValidationError{}
 
-// Validate checks the field values on AllBooksRequests with the rules defined
+// Validate checks the field values on ImportRowResult with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AllBooksRequests) Validate() error {
+func (m *ImportRowResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AllBooksRequests with the rules
+// ValidateAll checks the field values on ImportRowResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AllBooksRequestsMultiError, or nil if none found.
-func (m *AllBooksRequests) ValidateAll() error {
+// ImportRowResultMultiError, or nil if none found.
+func (m *ImportRowResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AllBooksRequests) validate(all bool) error {
+func (m *ImportRowResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Line
+
+	// no validation rules for Status
+
+	// no validation rules for BookId
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return AllBooksRequestsMultiError(errors)
+		return ImportRowResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// AllBooksRequestsMultiError is an error wrapping multiple validation errors
-// returned by AllBooksRequests.ValidateAll() if the designated constraints
+// ImportRowResultMultiError is an error wrapping multiple validation errors
+// returned by ImportRowResult.ValidateAll() if the designated constraints
 // aren't met.
-type AllBooksRequestsMultiError []error
+type ImportRowResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AllBooksRequestsMultiError) Error() string {
+func (m ImportRowResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -995,11 +1286,11 @@ func (m AllBooksRequestsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AllBooksRequestsMultiError) AllErrors() []error { return m }
+func (m ImportRowResultMultiError) AllErrors() []error { return m }
 
-// AllBooksRequestsValidationError is the validation error returned by
-// AllBooksRequests.Validate if the designated constraints aren't met.
-type AllBooksRequestsValidationError struct {
+// ImportRowResultValidationError is the validation error returned by
+// ImportRowResult.Validate if the designated constraints aren't met.
+type ImportRowResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1007,22 +1298,22 @@ type AllBooksRequestsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllBooksRequestsValidationError) Field() string { return e.field }
+func (e ImportRowResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllBooksRequestsValidationError) Reason() string { return e.reason }
+func (e ImportRowResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllBooksRequestsValidationError) Cause() error { return e.cause }
+func (e ImportRowResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllBooksRequestsValidationError) Key() bool { return e.key }
+func (e ImportRowResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllBooksRequestsValidationError) ErrorName() string { return "AllBooksRequestsValidationError" }
+func (e ImportRowResultValidationError) ErrorName() string { return "ImportRowResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AllBooksRequestsValidationError) Error() string {
+func (e ImportRowResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1034,14 +1325,14 @@ func (e AllBooksRequestsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllBooksRequests.%s: %s%s",
+		"invalid %sImportRowResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllBooksRequestsValidationError{}
+var _ error = ImportRowResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1049,47 +1340,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllBooksRequestsValidationError{}
+} = ImportRowResultValidationError{}
 
-// Validate checks the field values on AllBooksResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AllBooksResponse) Validate() error {
+// Validate checks the field values on ImportBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBooksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AllBooksResponse with the rules
+// ValidateAll checks the field values on ImportBooksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AllBooksResponseMultiError, or nil if none found.
-func (m *AllBooksResponse) ValidateAll() error {
+// ImportBooksResponseMultiError, or nil if none found.
+func (m *ImportBooksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AllBooksResponse) validate(all bool) error {
+func (m *ImportBooksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBooks() {
+	// no validation rules for Created
+
+	// no validation rules for Skipped
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetRows() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AllBooksResponseValidationError{
-						field:  fmt.Sprintf("Books[%v]", idx),
+					errors = append(errors, ImportBooksResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AllBooksResponseValidationError{
-						field:  fmt.Sprintf("Books[%v]", idx),
+					errors = append(errors, ImportBooksResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1097,8 +1394,8 @@ func (m *AllBooksResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AllBooksResponseValidationError{
-					field:  fmt.Sprintf("Books[%v]", idx),
+				return ImportBooksResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1108,19 +1405,19 @@ func (m *AllBooksResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AllBooksResponseMultiError(errors)
+		return ImportBooksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AllBooksResponseMultiError is an error wrapping multiple validation errors
-// returned by AllBooksResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AllBooksResponseMultiError []error
+// ImportBooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportBooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportBooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AllBooksResponseMultiError) Error() string {
+func (m ImportBooksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1129,11 +1426,11 @@ func (m AllBooksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AllBooksResponseMultiError) AllErrors() []error { return m }
+func (m ImportBooksResponseMultiError) AllErrors() []error { return m }
 
-// AllBooksResponseValidationError is the validation error returned by
-// AllBooksResponse.Validate if the designated constraints aren't met.
-type AllBooksResponseValidationError struct {
+// ImportBooksResponseValidationError is the validation error returned by
+// ImportBooksResponse.Validate if the designated constraints aren't met.
+type ImportBooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1141,22 +1438,24 @@ type AllBooksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllBooksResponseValidationError) Field() string { return e.field }
+func (e ImportBooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllBooksResponseValidationError) Reason() string { return e.reason }
+func (e ImportBooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllBooksResponseValidationError) Cause() error { return e.cause }
+func (e ImportBooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllBooksResponseValidationError) Key() bool { return e.key }
+func (e ImportBooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllBooksResponseValidationError) ErrorName() string { return "AllBooksResponseValidationError" }
+func (e ImportBooksResponseValidationError) ErrorName() string {
+	return "ImportBooksResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AllBooksResponseValidationError) Error() string {
+func (e ImportBooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1168,14 +1467,14 @@ func (e AllBooksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllBooksResponse.%s: %s%s",
+		"invalid %sImportBooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllBooksResponseValidationError{}
+var _ error = ImportBooksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1183,48 +1482,187 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllBooksResponseValidationError{}
+} = ImportBooksResponseValidationError{}
 
-// Validate checks the field values on RegistrationRequest with the rules
+// Validate checks the field values on ExportBooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RegistrationRequest) Validate() error {
+func (m *ExportBooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RegistrationRequest with the rules
+// ValidateAll checks the field values on ExportBooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RegistrationRequestMultiError, or nil if none found.
-func (m *RegistrationRequest) ValidateAll() error {
+// ExportBooksRequestMultiError, or nil if none found.
+func (m *ExportBooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RegistrationRequest) validate(all bool) error {
+func (m *ExportBooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if _, ok := _ExportBooksRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportBooksRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv jsonl bibtex marcxml]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Password
+	if utf8.RuneCountInString(m.GetTitleContains()) > 32 {
+		err := ExportBooksRequestValidationError{
+			field:  "TitleContains",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	if m.YearMin != nil {
+		// no validation rules for YearMin
+	}
+
+	if m.YearMax != nil {
+		// no validation rules for YearMax
+	}
 
 	if len(errors) > 0 {
-		return RegistrationRequestMultiError(errors)
+		return ExportBooksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RegistrationRequestMultiError is an error wrapping multiple validation
-// errors returned by RegistrationRequest.ValidateAll() if the designated
+// ExportBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportBooksRequestMultiError) AllErrors() []error { return m }
+
+// ExportBooksRequestValidationError is the validation error returned by
+// ExportBooksRequest.Validate if the designated constraints aren't met.
+type ExportBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportBooksRequestValidationError) ErrorName() string {
+	return "ExportBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportBooksRequestValidationError{}
+
+var _ExportBooksRequest_Format_InLookup = map[string]struct{}{
+	"csv":     {},
+	"jsonl":   {},
+	"bibtex":  {},
+	"marcxml": {},
+}
+
+// Validate checks the field values on ExportBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBooksResponseMultiError, or nil if none found.
+func (m *ExportBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return ExportBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportBooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportBooksResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RegistrationRequestMultiError []error
+type ExportBooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RegistrationRequestMultiError) Error() string {
+func (m ExportBooksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1233,11 +1671,11 @@ func (m RegistrationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RegistrationRequestMultiError) AllErrors() []error { return m }
+func (m ExportBooksResponseMultiError) AllErrors() []error { return m }
 
-// RegistrationRequestValidationError is the validation error returned by
-// RegistrationRequest.Validate if the designated constraints aren't met.
-type RegistrationRequestValidationError struct {
+// ExportBooksResponseValidationError is the validation error returned by
+// ExportBooksResponse.Validate if the designated constraints aren't met.
+type ExportBooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1245,24 +1683,24 @@ type RegistrationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RegistrationRequestValidationError) Field() string { return e.field }
+func (e ExportBooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RegistrationRequestValidationError) Reason() string { return e.reason }
+func (e ExportBooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RegistrationRequestValidationError) Cause() error { return e.cause }
+func (e ExportBooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RegistrationRequestValidationError) Key() bool { return e.key }
+func (e ExportBooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RegistrationRequestValidationError) ErrorName() string {
-	return "RegistrationRequestValidationError"
+func (e ExportBooksResponseValidationError) ErrorName() string {
+	return "ExportBooksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RegistrationRequestValidationError) Error() string {
+func (e ExportBooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1274,14 +1712,5313 @@ func (e RegistrationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRegistrationRequest.%s: %s%s",
+		"invalid %sExportBooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RegistrationRequestValidationError{}
+var _ error = ExportBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportBooksResponseValidationError{}
+
+// Validate checks the field values on EnrichBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrichBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichBookRequestMultiError, or nil if none found.
+func (m *EnrichBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetIsbn()); l < 10 || l > 17 {
+		err := EnrichBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be between 10 and 17 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_EnrichBookRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := EnrichBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnrichBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichBookRequestMultiError is an error wrapping multiple validation errors
+// returned by EnrichBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichBookRequestMultiError) AllErrors() []error { return m }
+
+// EnrichBookRequestValidationError is the validation error returned by
+// EnrichBookRequest.Validate if the designated constraints aren't met.
+type EnrichBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichBookRequestValidationError) ErrorName() string {
+	return "EnrichBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichBookRequestValidationError{}
+
+var _EnrichBookRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
+// Validate checks the field values on EnrichBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrichBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichBookResponseMultiError, or nil if none found.
+func (m *EnrichBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnrichBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnrichBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnrichBookResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnrichBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichBookResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrichBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichBookResponseMultiError) AllErrors() []error { return m }
+
+// EnrichBookResponseValidationError is the validation error returned by
+// EnrichBookResponse.Validate if the designated constraints aren't met.
+type EnrichBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichBookResponseValidationError) ErrorName() string {
+	return "EnrichBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichBookResponseValidationError{}
+
+// Validate checks the field values on DeleteBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookRequestMultiError, or nil if none found.
+func (m *DeleteBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookRequestValidationError is the validation error returned by
+// DeleteBookRequest.Validate if the designated constraints aren't met.
+type DeleteBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookRequestValidationError) ErrorName() string {
+	return "DeleteBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookRequestValidationError{}
+
+// Validate checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookResponseMultiError, or nil if none found.
+func (m *DeleteBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookResponseValidationError is the validation error returned by
+// DeleteBookResponse.Validate if the designated constraints aren't met.
+type DeleteBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookResponseValidationError) ErrorName() string {
+	return "DeleteBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookResponseValidationError{}
+
+// Validate checks the field values on ListDeletedBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedBooksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedBooksRequestMultiError, or nil if none found.
+func (m *ListDeletedBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListDeletedBooksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListDeletedBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedBooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedBooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedBooksRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedBooksRequestValidationError is the validation error returned by
+// ListDeletedBooksRequest.Validate if the designated constraints aren't met.
+type ListDeletedBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedBooksRequestValidationError) ErrorName() string {
+	return "ListDeletedBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedBooksRequestValidationError{}
+
+// Validate checks the field values on ListDeletedBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedBooksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedBooksResponseMultiError, or nil if none found.
+func (m *ListDeletedBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedBooksResponseValidationError{
+					field:  fmt.Sprintf("Books[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeletedBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedBooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedBooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedBooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedBooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedBooksResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletedBooksResponseValidationError is the validation error returned by
+// ListDeletedBooksResponse.Validate if the designated constraints aren't met.
+type ListDeletedBooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedBooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedBooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedBooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedBooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedBooksResponseValidationError) ErrorName() string {
+	return "ListDeletedBooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedBooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedBooksResponseValidationError{}
+
+// Validate checks the field values on BookRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookRevisionMultiError, or
+// nil if none found.
+func (m *BookRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BookId
+
+	// no validation rules for Version
+
+	// no validation rules for Action
+
+	// no validation rules for ActorId
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookRevisionValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookRevisionValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookRevisionValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookRevisionValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookRevisionMultiError is an error wrapping multiple validation errors
+// returned by BookRevision.ValidateAll() if the designated constraints aren't met.
+type BookRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookRevisionMultiError) AllErrors() []error { return m }
+
+// BookRevisionValidationError is the validation error returned by
+// BookRevision.Validate if the designated constraints aren't met.
+type BookRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookRevisionValidationError) ErrorName() string { return "BookRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookRevisionValidationError{}
+
+// Validate checks the field values on ListBookRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookRevisionsRequestMultiError, or nil if none found.
+func (m *ListBookRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookId
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListBookRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBookRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListBookRevisionsRequestValidationError is the validation error returned by
+// ListBookRevisionsRequest.Validate if the designated constraints aren't met.
+type ListBookRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookRevisionsRequestValidationError) ErrorName() string {
+	return "ListBookRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListBookRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookRevisionsResponseMultiError, or nil if none found.
+func (m *ListBookRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBookRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookRevisionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListBookRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListBookRevisionsResponseValidationError is the validation error returned by
+// ListBookRevisionsResponse.Validate if the designated constraints aren't met.
+type ListBookRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookRevisionsResponseValidationError) ErrorName() string {
+	return "ListBookRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookRevisionsResponseValidationError{}
+
+// Validate checks the field values on RevertBookToRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertBookToRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertBookToRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertBookToRevisionRequestMultiError, or nil if none found.
+func (m *RevertBookToRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertBookToRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookId
+
+	// no validation rules for RevisionId
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return RevertBookToRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertBookToRevisionRequestMultiError is an error wrapping multiple
+// validation errors returned by RevertBookToRevisionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevertBookToRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertBookToRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertBookToRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RevertBookToRevisionRequestValidationError is the validation error returned
+// by RevertBookToRevisionRequest.Validate if the designated constraints
+// aren't met.
+type RevertBookToRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertBookToRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertBookToRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertBookToRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertBookToRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertBookToRevisionRequestValidationError) ErrorName() string {
+	return "RevertBookToRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertBookToRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertBookToRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertBookToRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertBookToRevisionRequestValidationError{}
+
+// Validate checks the field values on RevertBookToRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertBookToRevisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertBookToRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertBookToRevisionResponseMultiError, or nil if none found.
+func (m *RevertBookToRevisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertBookToRevisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevertBookToRevisionResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevertBookToRevisionResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevertBookToRevisionResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevertBookToRevisionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertBookToRevisionResponseMultiError is an error wrapping multiple
+// validation errors returned by RevertBookToRevisionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevertBookToRevisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertBookToRevisionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertBookToRevisionResponseMultiError) AllErrors() []error { return m }
+
+// RevertBookToRevisionResponseValidationError is the validation error returned
+// by RevertBookToRevisionResponse.Validate if the designated constraints
+// aren't met.
+type RevertBookToRevisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertBookToRevisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertBookToRevisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertBookToRevisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertBookToRevisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertBookToRevisionResponseValidationError) ErrorName() string {
+	return "RevertBookToRevisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertBookToRevisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertBookToRevisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertBookToRevisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertBookToRevisionResponseValidationError{}
+
+// Validate checks the field values on UndeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteBookRequestMultiError, or nil if none found.
+func (m *UndeleteBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UndeleteBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteBookRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteBookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteBookRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteBookRequestValidationError is the validation error returned by
+// UndeleteBookRequest.Validate if the designated constraints aren't met.
+type UndeleteBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteBookRequestValidationError) ErrorName() string {
+	return "UndeleteBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteBookRequestValidationError{}
+
+// Validate checks the field values on UndeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteBookResponseMultiError, or nil if none found.
+func (m *UndeleteBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteBookResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteBookResponseMultiError is an error wrapping multiple validation
+// errors returned by UndeleteBookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteBookResponseMultiError) AllErrors() []error { return m }
+
+// UndeleteBookResponseValidationError is the validation error returned by
+// UndeleteBookResponse.Validate if the designated constraints aren't met.
+type UndeleteBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteBookResponseValidationError) ErrorName() string {
+	return "UndeleteBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteBookResponseValidationError{}
+
+// Validate checks the field values on UpdateBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookRequestMultiError, or nil if none found.
+func (m *UpdateBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetTitle()) > 32 {
+		err := UpdateBookRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetYear(); val < 0 || val > 9999 {
+		err := UpdateBookRequestValidationError{
+			field:  "Year",
+			reason: "value must be inside range [0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAuthorNames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := UpdateBookRequestValidationError{
+				field:  fmt.Sprintf("AuthorNames[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn()) > 17 {
+		err := UpdateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateBookRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := UpdateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEdition()) > 50 {
+		err := UpdateBookRequestValidationError{
+			field:  "Edition",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 200 {
+		err := UpdateBookRequestValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCoverUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetCoverUrl()) > 2048 {
+			err := UpdateBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetCoverUrl()); err != nil {
+			err = UpdateBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateBookRequestValidationError{
+				field:  "CoverUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookRequestValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookRequestValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookRequestValidationError is the validation error returned by
+// UpdateBookRequest.Validate if the designated constraints aren't met.
+type UpdateBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookRequestValidationError) ErrorName() string {
+	return "UpdateBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookRequestValidationError{}
+
+var _UpdateBookRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
+// Validate checks the field values on BookPatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookPatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookPatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookPatchMultiError, or nil
+// if none found.
+func (m *BookPatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookPatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTitle()) > 32 {
+		err := BookPatchValidationError{
+			field:  "Title",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetYear(); val < 0 || val > 9999 {
+		err := BookPatchValidationError{
+			field:  "Year",
+			reason: "value must be inside range [0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAuthorNames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := BookPatchValidationError{
+				field:  fmt.Sprintf("AuthorNames[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn()) > 17 {
+		err := BookPatchValidationError{
+			field:  "Isbn",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BookPatch_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := BookPatchValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEdition()) > 50 {
+		err := BookPatchValidationError{
+			field:  "Edition",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 200 {
+		err := BookPatchValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCoverUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetCoverUrl()) > 2048 {
+			err := BookPatchValidationError{
+				field:  "CoverUrl",
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetCoverUrl()); err != nil {
+			err = BookPatchValidationError{
+				field:  "CoverUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := BookPatchValidationError{
+				field:  "CoverUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookPatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookPatchMultiError is an error wrapping multiple validation errors returned
+// by BookPatch.ValidateAll() if the designated constraints aren't met.
+type BookPatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookPatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookPatchMultiError) AllErrors() []error { return m }
+
+// BookPatchValidationError is the validation error returned by
+// BookPatch.Validate if the designated constraints aren't met.
+type BookPatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookPatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookPatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookPatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookPatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookPatchValidationError) ErrorName() string { return "BookPatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookPatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookPatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookPatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookPatchValidationError{}
+
+var _BookPatch_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]*$")
+
+// Validate checks the field values on UpdateBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookResponseMultiError, or nil if none found.
+func (m *UpdateBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBookResponseValidationError is the validation error returned by
+// UpdateBookResponse.Validate if the designated constraints aren't met.
+type UpdateBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookResponseValidationError) ErrorName() string {
+	return "UpdateBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookResponseValidationError{}
+
+// Validate checks the field values on AllBooksRequests with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AllBooksRequests) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllBooksRequests with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllBooksRequestsMultiError, or nil if none found.
+func (m *AllBooksRequests) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllBooksRequests) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := AllBooksRequestsValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetTitleContains()) > 32 {
+		err := AllBooksRequestsValidationError{
+			field:  "TitleContains",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	if !_AllBooksRequests_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := AllBooksRequestsValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^((id|title|year)( (asc|desc))?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.YearMin != nil {
+		// no validation rules for YearMin
+	}
+
+	if m.YearMax != nil {
+		// no validation rules for YearMax
+	}
+
+	if len(errors) > 0 {
+		return AllBooksRequestsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllBooksRequestsMultiError is an error wrapping multiple validation errors
+// returned by AllBooksRequests.ValidateAll() if the designated constraints
+// aren't met.
+type AllBooksRequestsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllBooksRequestsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllBooksRequestsMultiError) AllErrors() []error { return m }
+
+// AllBooksRequestsValidationError is the validation error returned by
+// AllBooksRequests.Validate if the designated constraints aren't met.
+type AllBooksRequestsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllBooksRequestsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllBooksRequestsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllBooksRequestsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllBooksRequestsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllBooksRequestsValidationError) ErrorName() string { return "AllBooksRequestsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AllBooksRequestsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllBooksRequests.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllBooksRequestsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllBooksRequestsValidationError{}
+
+var _AllBooksRequests_OrderBy_Pattern = regexp.MustCompile("^((id|title|year)( (asc|desc))?)?$")
+
+// Validate checks the field values on AllBooksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AllBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllBooksResponseMultiError, or nil if none found.
+func (m *AllBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AllBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AllBooksResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllBooksResponseValidationError{
+					field:  fmt.Sprintf("Books[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return AllBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllBooksResponseMultiError is an error wrapping multiple validation errors
+// returned by AllBooksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AllBooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllBooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllBooksResponseMultiError) AllErrors() []error { return m }
+
+// AllBooksResponseValidationError is the validation error returned by
+// AllBooksResponse.Validate if the designated constraints aren't met.
+type AllBooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllBooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllBooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllBooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllBooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllBooksResponseValidationError) ErrorName() string { return "AllBooksResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AllBooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllBooksResponseValidationError{}
+
+// Validate checks the field values on StreamBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamBooksRequestMultiError, or nil if none found.
+func (m *StreamBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTitleContains()) > 32 {
+		err := StreamBooksRequestValidationError{
+			field:  "TitleContains",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	if !_StreamBooksRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := StreamBooksRequestValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^((id|title|year)( (asc|desc))?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := StreamBooksRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.YearMin != nil {
+		// no validation rules for YearMin
+	}
+
+	if m.YearMax != nil {
+		// no validation rules for YearMax
+	}
+
+	if len(errors) > 0 {
+		return StreamBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamBooksRequestMultiError) AllErrors() []error { return m }
+
+// StreamBooksRequestValidationError is the validation error returned by
+// StreamBooksRequest.Validate if the designated constraints aren't met.
+type StreamBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamBooksRequestValidationError) ErrorName() string {
+	return "StreamBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamBooksRequestValidationError{}
+
+var _StreamBooksRequest_OrderBy_Pattern = regexp.MustCompile("^((id|title|year)( (asc|desc))?)?$")
+
+// Validate checks the field values on SearchBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBooksRequestMultiError, or nil if none found.
+func (m *SearchBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 100 {
+		err := SearchBooksRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchBooksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBooksRequestMultiError) AllErrors() []error { return m }
+
+// SearchBooksRequestValidationError is the validation error returned by
+// SearchBooksRequest.Validate if the designated constraints aren't met.
+type SearchBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBooksRequestValidationError) ErrorName() string {
+	return "SearchBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBooksRequestValidationError{}
+
+// Validate checks the field values on SearchBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBooksResponseMultiError, or nil if none found.
+func (m *SearchBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchBooksResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchBooksResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchBooksResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBooksResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchBooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBooksResponseMultiError) AllErrors() []error { return m }
+
+// SearchBooksResponseValidationError is the validation error returned by
+// SearchBooksResponse.Validate if the designated constraints aren't met.
+type SearchBooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBooksResponseValidationError) ErrorName() string {
+	return "SearchBooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBooksResponseValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on AddAuthorRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAuthorRequestMultiError, or nil if none found.
+func (m *AddAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := AddAuthorRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAuthorRequestMultiError is an error wrapping multiple validation errors
+// returned by AddAuthorRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAuthorRequestMultiError) AllErrors() []error { return m }
+
+// AddAuthorRequestValidationError is the validation error returned by
+// AddAuthorRequest.Validate if the designated constraints aren't met.
+type AddAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAuthorRequestValidationError) ErrorName() string { return "AddAuthorRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAuthorRequestValidationError{}
+
+// Validate checks the field values on AddAuthorResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAuthorResponseMultiError, or nil if none found.
+func (m *AddAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddAuthorResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAuthorResponseMultiError is an error wrapping multiple validation errors
+// returned by AddAuthorResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAuthorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAuthorResponseMultiError) AllErrors() []error { return m }
+
+// AddAuthorResponseValidationError is the validation error returned by
+// AddAuthorResponse.Validate if the designated constraints aren't met.
+type AddAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAuthorResponseValidationError) ErrorName() string {
+	return "AddAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAuthorResponseValidationError{}
+
+// Validate checks the field values on GetAuthorRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorRequestMultiError, or nil if none found.
+func (m *GetAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAuthorRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthorRequestValidationError is the validation error returned by
+// GetAuthorRequest.Validate if the designated constraints aren't met.
+type GetAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorRequestValidationError) ErrorName() string { return "GetAuthorRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorRequestValidationError{}
+
+// Validate checks the field values on GetAuthorResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorResponseMultiError, or nil if none found.
+func (m *GetAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAuthorResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorResponseMultiError) AllErrors() []error { return m }
+
+// GetAuthorResponseValidationError is the validation error returned by
+// GetAuthorResponse.Validate if the designated constraints aren't met.
+type GetAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorResponseValidationError) ErrorName() string {
+	return "GetAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorResponseValidationError{}
+
+// Validate checks the field values on ListAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthorsRequestMultiError, or nil if none found.
+func (m *ListAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListAuthorsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetNameContains()) > 100 {
+		err := ListAuthorsRequestValidationError{
+			field:  "NameContains",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthorsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAuthorsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuthorsRequestValidationError is the validation error returned by
+// ListAuthorsRequest.Validate if the designated constraints aren't met.
+type ListAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorsRequestValidationError) ErrorName() string {
+	return "ListAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorsRequestValidationError{}
+
+// Validate checks the field values on ListAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthorsResponseMultiError, or nil if none found.
+func (m *ListAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuthorsResponseValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthorsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuthorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuthorsResponseValidationError is the validation error returned by
+// ListAuthorsResponse.Validate if the designated constraints aren't met.
+type ListAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorsResponseValidationError) ErrorName() string {
+	return "ListAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorsResponseValidationError{}
+
+// Validate checks the field values on MergeAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeAuthorsRequestMultiError, or nil if none found.
+func (m *MergeAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetId
+
+	if len(m.GetSourceIds()) < 1 {
+		err := MergeAuthorsRequestValidationError{
+			field:  "SourceIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MergeAuthorsRequest_SourceIds_Unique := make(map[int64]struct{}, len(m.GetSourceIds()))
+
+	for idx, item := range m.GetSourceIds() {
+		_, _ = idx, item
+
+		if _, exists := _MergeAuthorsRequest_SourceIds_Unique[item]; exists {
+			err := MergeAuthorsRequestValidationError{
+				field:  fmt.Sprintf("SourceIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MergeAuthorsRequest_SourceIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for SourceIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return MergeAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeAuthorsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeAuthorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// MergeAuthorsRequestValidationError is the validation error returned by
+// MergeAuthorsRequest.Validate if the designated constraints aren't met.
+type MergeAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeAuthorsRequestValidationError) ErrorName() string {
+	return "MergeAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeAuthorsRequestValidationError{}
+
+// Validate checks the field values on MergeAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeAuthorsResponseMultiError, or nil if none found.
+func (m *MergeAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MergeAuthorsResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MergeAuthorsResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MergeAuthorsResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MergeAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeAuthorsResponseMultiError is an error wrapping multiple validation
+// errors returned by MergeAuthorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MergeAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeAuthorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// MergeAuthorsResponseValidationError is the validation error returned by
+// MergeAuthorsResponse.Validate if the designated constraints aren't met.
+type MergeAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeAuthorsResponseValidationError) ErrorName() string {
+	return "MergeAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeAuthorsResponseValidationError{}
+
+// Validate checks the field values on RegistrationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationRequestMultiError, or nil if none found.
+func (m *RegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEmail()) > 254 {
+		err := RegistrationRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at most 254 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RegistrationRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 8 {
+		err := RegistrationRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 8 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPassword()) > 72 {
+		err := RegistrationRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at most 72 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RegistrationRequest_Password_Pattern.MatchString(m.GetPassword()) {
+		err := RegistrationRequestValidationError{
+			field:  "Password",
+			reason: "value does not match regex pattern \"^(.*[A-Za-z].*[0-9]|.*[0-9].*[A-Za-z]).*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RegistrationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RegistrationRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RegistrationRequestMultiError is an error wrapping multiple validation
+// errors returned by RegistrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationRequestMultiError) AllErrors() []error { return m }
+
+// RegistrationRequestValidationError is the validation error returned by
+// RegistrationRequest.Validate if the designated constraints aren't met.
+type RegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationRequestValidationError) ErrorName() string {
+	return "RegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationRequestValidationError{}
+
+var _RegistrationRequest_Password_Pattern = regexp.MustCompile("^(.*[A-Za-z].*[0-9]|.*[0-9].*[A-Za-z]).*$")
+
+// Validate checks the field values on RegistrationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationResponseMultiError, or nil if none found.
+func (m *RegistrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RegistrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegistrationResponseMultiError is an error wrapping multiple validation
+// errors returned by RegistrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegistrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationResponseMultiError) AllErrors() []error { return m }
+
+// RegistrationResponseValidationError is the validation error returned by
+// RegistrationResponse.Validate if the designated constraints aren't met.
+type RegistrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationResponseValidationError) ErrorName() string {
+	return "RegistrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegistrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationResponseValidationError{}
+
+// Validate checks the field values on LoginRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginRequestMultiError, or
+// nil if none found.
+func (m *LoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return LoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginRequestMultiError is an error wrapping multiple validation errors
+// returned by LoginRequest.ValidateAll() if the designated constraints aren't met.
+type LoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginRequestMultiError) AllErrors() []error { return m }
+
+// LoginRequestValidationError is the validation error returned by
+// LoginRequest.Validate if the designated constraints aren't met.
+type LoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginRequestValidationError) ErrorName() string { return "LoginRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginRequestValidationError{}
+
+// Validate checks the field values on LoginResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// nil if none found.
+func (m *LoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoginResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginResponseMultiError is an error wrapping multiple validation errors
+// returned by LoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginResponseMultiError) AllErrors() []error { return m }
+
+// LoginResponseValidationError is the validation error returned by
+// LoginResponse.Validate if the designated constraints aren't met.
+type LoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginResponseValidationError{}
+
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
+
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutRequestValidationError{}
+
+// Validate checks the field values on LogoutResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutResponseMultiError,
+// or nil if none found.
+func (m *LogoutResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LogoutResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutResponseMultiError is an error wrapping multiple validation errors
+// returned by LogoutResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutResponseMultiError) AllErrors() []error { return m }
+
+// LogoutResponseValidationError is the validation error returned by
+// LogoutResponse.Validate if the designated constraints aren't met.
+type LogoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutResponseValidationError) ErrorName() string { return "LogoutResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutResponseValidationError{}
+
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsRequestValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionResponseMultiError, or nil if none found.
+func (m *RevokeSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionResponseValidationError is the validation error returned by
+// RevokeSessionResponse.Validate if the designated constraints aren't met.
+type RevokeSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionResponseValidationError) ErrorName() string {
+	return "RevokeSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1289,46 +7026,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RegistrationRequestValidationError{}
+} = RevokeSessionResponseValidationError{}
 
-// Validate checks the field values on RegistrationResponse with the rules
+// Validate checks the field values on RevokeAllSessionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RegistrationResponse) Validate() error {
+func (m *RevokeAllSessionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RegistrationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeAllSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RegistrationResponseMultiError, or nil if none found.
-func (m *RegistrationResponse) ValidateAll() error {
+// RevokeAllSessionsRequestMultiError, or nil if none found.
+func (m *RevokeAllSessionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RegistrationResponse) validate(all bool) error {
+func (m *RevokeAllSessionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for KeepCurrent
 
 	if len(errors) > 0 {
-		return RegistrationResponseMultiError(errors)
+		return RevokeAllSessionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RegistrationResponseMultiError is an error wrapping multiple validation
-// errors returned by RegistrationResponse.ValidateAll() if the designated
+// RevokeAllSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAllSessionsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RegistrationResponseMultiError []error
+type RevokeAllSessionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RegistrationResponseMultiError) Error() string {
+func (m RevokeAllSessionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1337,11 +7074,11 @@ func (m RegistrationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RegistrationResponseMultiError) AllErrors() []error { return m }
+func (m RevokeAllSessionsRequestMultiError) AllErrors() []error { return m }
 
-// RegistrationResponseValidationError is the validation error returned by
-// RegistrationResponse.Validate if the designated constraints aren't met.
-type RegistrationResponseValidationError struct {
+// RevokeAllSessionsRequestValidationError is the validation error returned by
+// RevokeAllSessionsRequest.Validate if the designated constraints aren't met.
+type RevokeAllSessionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1349,24 +7086,24 @@ type RegistrationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RegistrationResponseValidationError) Field() string { return e.field }
+func (e RevokeAllSessionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RegistrationResponseValidationError) Reason() string { return e.reason }
+func (e RevokeAllSessionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RegistrationResponseValidationError) Cause() error { return e.cause }
+func (e RevokeAllSessionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RegistrationResponseValidationError) Key() bool { return e.key }
+func (e RevokeAllSessionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RegistrationResponseValidationError) ErrorName() string {
-	return "RegistrationResponseValidationError"
+func (e RevokeAllSessionsRequestValidationError) ErrorName() string {
+	return "RevokeAllSessionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RegistrationResponseValidationError) Error() string {
+func (e RevokeAllSessionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1378,14 +7115,14 @@ func (e RegistrationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRegistrationResponse.%s: %s%s",
+		"invalid %sRevokeAllSessionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RegistrationResponseValidationError{}
+var _ error = RevokeAllSessionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1393,47 +7130,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RegistrationResponseValidationError{}
+} = RevokeAllSessionsRequestValidationError{}
 
-// Validate checks the field values on LoginRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LoginRequest) Validate() error {
+// Validate checks the field values on RevokeAllSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllSessionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoginRequestMultiError, or
-// nil if none found.
-func (m *LoginRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeAllSessionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllSessionsResponseMultiError, or nil if none found.
+func (m *RevokeAllSessionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginRequest) validate(all bool) error {
+func (m *RevokeAllSessionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
-
-	// no validation rules for Password
+	// no validation rules for Revoked
 
 	if len(errors) > 0 {
-		return LoginRequestMultiError(errors)
+		return RevokeAllSessionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginRequestMultiError is an error wrapping multiple validation errors
-// returned by LoginRequest.ValidateAll() if the designated constraints aren't met.
-type LoginRequestMultiError []error
+// RevokeAllSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAllSessionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeAllSessionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginRequestMultiError) Error() string {
+func (m RevokeAllSessionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1442,11 +7178,11 @@ func (m LoginRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginRequestMultiError) AllErrors() []error { return m }
+func (m RevokeAllSessionsResponseMultiError) AllErrors() []error { return m }
 
-// LoginRequestValidationError is the validation error returned by
-// LoginRequest.Validate if the designated constraints aren't met.
-type LoginRequestValidationError struct {
+// RevokeAllSessionsResponseValidationError is the validation error returned by
+// RevokeAllSessionsResponse.Validate if the designated constraints aren't met.
+type RevokeAllSessionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1454,22 +7190,24 @@ type LoginRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginRequestValidationError) Field() string { return e.field }
+func (e RevokeAllSessionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginRequestValidationError) Reason() string { return e.reason }
+func (e RevokeAllSessionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginRequestValidationError) Cause() error { return e.cause }
+func (e RevokeAllSessionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginRequestValidationError) Key() bool { return e.key }
+func (e RevokeAllSessionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginRequestValidationError) ErrorName() string { return "LoginRequestValidationError" }
+func (e RevokeAllSessionsResponseValidationError) ErrorName() string {
+	return "RevokeAllSessionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LoginRequestValidationError) Error() string {
+func (e RevokeAllSessionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1481,14 +7219,14 @@ func (e LoginRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginRequest.%s: %s%s",
+		"invalid %sRevokeAllSessionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginRequestValidationError{}
+var _ error = RevokeAllSessionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1496,73 +7234,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginRequestValidationError{}
+} = RevokeAllSessionsResponseValidationError{}
 
-// Validate checks the field values on LoginResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LoginResponse) Validate() error {
+func (m *Session) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoginResponseMultiError, or
-// nil if none found.
-func (m *LoginResponse) ValidateAll() error {
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginResponse) validate(all bool) error {
+func (m *Session) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LoginResponseValidationError{
-					field:  "User",
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LoginResponseValidationError{
-					field:  "User",
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LoginResponseValidationError{
-				field:  "User",
+			return SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "LastSeenAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Current
+
 	if len(errors) > 0 {
-		return LoginResponseMultiError(errors)
+		return SessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginResponseMultiError is an error wrapping multiple validation errors
-// returned by LoginResponse.ValidateAll() if the designated constraints
-// aren't met.
-type LoginResponseMultiError []error
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginResponseMultiError) Error() string {
+func (m SessionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1571,11 +7344,11 @@ func (m LoginResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginResponseMultiError) AllErrors() []error { return m }
+func (m SessionMultiError) AllErrors() []error { return m }
 
-// LoginResponseValidationError is the validation error returned by
-// LoginResponse.Validate if the designated constraints aren't met.
-type LoginResponseValidationError struct {
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1583,22 +7356,22 @@ type LoginResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginResponseValidationError) Field() string { return e.field }
+func (e SessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginResponseValidationError) Reason() string { return e.reason }
+func (e SessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginResponseValidationError) Cause() error { return e.cause }
+func (e SessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginResponseValidationError) Key() bool { return e.key }
+func (e SessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginResponseValidationError) Error() string {
+func (e SessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1610,14 +7383,14 @@ func (e LoginResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginResponse.%s: %s%s",
+		"invalid %sSession.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginResponseValidationError{}
+var _ error = SessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1625,7 +7398,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginResponseValidationError{}
+} = SessionValidationError{}
 
 // Validate checks the field values on User with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
@@ -1785,6 +7558,79 @@ func (m *Book) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Isbn
+
+	// no validation rules for Edition
+
+	// no validation rules for Publisher
+
+	// no validation rules for CoverUrl
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BookMultiError(errors)
 	}
@@ -1861,3 +7707,105 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BookValidationError{}
+
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
@@ -19,13 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookAPI_AddBook_FullMethodName      = "/api.proto.v1.BookAPI/AddBook"
-	BookAPI_GetBook_FullMethodName      = "/api.proto.v1.BookAPI/GetBook"
-	BookAPI_DeleteBook_FullMethodName   = "/api.proto.v1.BookAPI/DeleteBook"
-	BookAPI_UpdateBook_FullMethodName   = "/api.proto.v1.BookAPI/UpdateBook"
-	BookAPI_AllBooks_FullMethodName     = "/api.proto.v1.BookAPI/AllBooks"
-	BookAPI_Registration_FullMethodName = "/api.proto.v1.BookAPI/Registration"
-	BookAPI_Login_FullMethodName        = "/api.proto.v1.BookAPI/Login"
+	BookAPI_AddBook_FullMethodName              = "/api.proto.v1.BookAPI/AddBook"
+	BookAPI_GetBook_FullMethodName              = "/api.proto.v1.BookAPI/GetBook"
+	BookAPI_GetBookByIsbn_FullMethodName        = "/api.proto.v1.BookAPI/GetBookByIsbn"
+	BookAPI_EnrichBook_FullMethodName           = "/api.proto.v1.BookAPI/EnrichBook"
+	BookAPI_DeleteBook_FullMethodName           = "/api.proto.v1.BookAPI/DeleteBook"
+	BookAPI_ListDeletedBooks_FullMethodName     = "/api.proto.v1.BookAPI/ListDeletedBooks"
+	BookAPI_UndeleteBook_FullMethodName         = "/api.proto.v1.BookAPI/UndeleteBook"
+	BookAPI_UpdateBook_FullMethodName           = "/api.proto.v1.BookAPI/UpdateBook"
+	BookAPI_ListBookRevisions_FullMethodName    = "/api.proto.v1.BookAPI/ListBookRevisions"
+	BookAPI_RevertBookToRevision_FullMethodName = "/api.proto.v1.BookAPI/RevertBookToRevision"
+	BookAPI_AllBooks_FullMethodName             = "/api.proto.v1.BookAPI/AllBooks"
+	BookAPI_StreamBooks_FullMethodName          = "/api.proto.v1.BookAPI/StreamBooks"
+	BookAPI_SearchBooks_FullMethodName          = "/api.proto.v1.BookAPI/SearchBooks"
+	BookAPI_ImportBooks_FullMethodName          = "/api.proto.v1.BookAPI/ImportBooks"
+	BookAPI_ExportBooks_FullMethodName          = "/api.proto.v1.BookAPI/ExportBooks"
+	BookAPI_AddAuthor_FullMethodName            = "/api.proto.v1.BookAPI/AddAuthor"
+	BookAPI_GetAuthor_FullMethodName            = "/api.proto.v1.BookAPI/GetAuthor"
+	BookAPI_ListAuthors_FullMethodName          = "/api.proto.v1.BookAPI/ListAuthors"
+	BookAPI_MergeAuthors_FullMethodName         = "/api.proto.v1.BookAPI/MergeAuthors"
+	BookAPI_Registration_FullMethodName         = "/api.proto.v1.BookAPI/Registration"
+	BookAPI_Login_FullMethodName                = "/api.proto.v1.BookAPI/Login"
+	BookAPI_Logout_FullMethodName               = "/api.proto.v1.BookAPI/Logout"
+	BookAPI_ListSessions_FullMethodName         = "/api.proto.v1.BookAPI/ListSessions"
+	BookAPI_RevokeSession_FullMethodName        = "/api.proto.v1.BookAPI/RevokeSession"
+	BookAPI_RevokeAllSessions_FullMethodName    = "/api.proto.v1.BookAPI/RevokeAllSessions"
 )
 
 // BookAPIClient is the client API for BookAPI service.
@@ -34,11 +52,45 @@ const (
 type BookAPIClient interface {
 	AddBook(ctx context.Context, in *AddBookRequest, opts ...grpc.CallOption) (*AddBookResponse, error)
 	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
+	GetBookByIsbn(ctx context.Context, in *GetBookByIsbnRequest, opts ...grpc.CallOption) (*GetBookByIsbnResponse, error)
+	// Ищет метаданные книги во внешних каталогах, ничего не сохраняя.
+	EnrichBook(ctx context.Context, in *EnrichBookRequest, opts ...grpc.CallOption) (*EnrichBookResponse, error)
+	// Книга попадает в корзину и через trash retention удаляется насовсем.
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
+	// Книги в корзине: свои, у администратора — все.
+	ListDeletedBooks(ctx context.Context, in *ListDeletedBooksRequest, opts ...grpc.CallOption) (*ListDeletedBooksResponse, error)
+	UndeleteBook(ctx context.Context, in *UndeleteBookRequest, opts ...grpc.CallOption) (*UndeleteBookResponse, error)
+	// Без update_mask заменяет все поля, с ним — только перечисленные.
+	// PATCH меняет только поля, переданные в теле: из них gateway
+	// составляет update_mask.
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
+	// История изменений книги, от старых к новым. Книги из корзины тоже.
+	ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpc.CallOption) (*ListBookRevisionsResponse, error)
+	// Возвращает поля и авторов книги к состоянию после ревизии.
+	// Сам откат тоже попадает в историю.
+	RevertBookToRevision(ctx context.Context, in *RevertBookToRevisionRequest, opts ...grpc.CallOption) (*RevertBookToRevisionResponse, error)
 	AllBooks(ctx context.Context, in *AllBooksRequests, opts ...grpc.CallOption) (*AllBooksResponse, error)
+	// Все книги под фильтром по одной, без страниц. Gateway отдаёт
+	// NDJSON: строка {"result": книга} на каждую книгу.
+	StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Book], error)
+	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error)
+	// Импорт книг из CSV или JSON Lines. Первое сообщение несёт options,
+	// следующие — файл кусками подряд. Через gateway: POST /books:import.
+	ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBooksRequest, ImportBooksResponse], error)
+	// Выгрузка каталога в csv, jsonl, bibtex или marcxml кусками файла.
+	// Через gateway: GET /books:export?format=csv.
+	ExportBooks(ctx context.Context, in *ExportBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportBooksResponse], error)
+	AddAuthor(ctx context.Context, in *AddAuthorRequest, opts ...grpc.CallOption) (*AddAuthorResponse, error)
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
+	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
+	// Переносит книги source_ids на target_id и удаляет source_ids. Только для администраторов.
+	MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error)
 	Registration(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*RegistrationResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
 }
 
 type bookAPIClient struct {
@@ -69,6 +121,26 @@ func (c *bookAPIClient) GetBook(ctx context.Context, in *GetBookRequest, opts ..
 	return out, nil
 }
 
+func (c *bookAPIClient) GetBookByIsbn(ctx context.Context, in *GetBookByIsbnRequest, opts ...grpc.CallOption) (*GetBookByIsbnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookByIsbnResponse)
+	err := c.cc.Invoke(ctx, BookAPI_GetBookByIsbn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) EnrichBook(ctx context.Context, in *EnrichBookRequest, opts ...grpc.CallOption) (*EnrichBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrichBookResponse)
+	err := c.cc.Invoke(ctx, BookAPI_EnrichBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookAPIClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteBookResponse)
@@ -79,6 +151,26 @@ func (c *bookAPIClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, o
 	return out, nil
 }
 
+func (c *bookAPIClient) ListDeletedBooks(ctx context.Context, in *ListDeletedBooksRequest, opts ...grpc.CallOption) (*ListDeletedBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedBooksResponse)
+	err := c.cc.Invoke(ctx, BookAPI_ListDeletedBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) UndeleteBook(ctx context.Context, in *UndeleteBookRequest, opts ...grpc.CallOption) (*UndeleteBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteBookResponse)
+	err := c.cc.Invoke(ctx, BookAPI_UndeleteBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookAPIClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateBookResponse)
@@ -89,6 +181,26 @@ func (c *bookAPIClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, o
 	return out, nil
 }
 
+func (c *bookAPIClient) ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpc.CallOption) (*ListBookRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookRevisionsResponse)
+	err := c.cc.Invoke(ctx, BookAPI_ListBookRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) RevertBookToRevision(ctx context.Context, in *RevertBookToRevisionRequest, opts ...grpc.CallOption) (*RevertBookToRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertBookToRevisionResponse)
+	err := c.cc.Invoke(ctx, BookAPI_RevertBookToRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookAPIClient) AllBooks(ctx context.Context, in *AllBooksRequests, opts ...grpc.CallOption) (*AllBooksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AllBooksResponse)
@@ -99,6 +211,107 @@ func (c *bookAPIClient) AllBooks(ctx context.Context, in *AllBooksRequests, opts
 	return out, nil
 }
 
+func (c *bookAPIClient) StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Book], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookAPI_ServiceDesc.Streams[0], BookAPI_StreamBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBooksRequest, Book]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_StreamBooksClient = grpc.ServerStreamingClient[Book]
+
+func (c *bookAPIClient) SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBooksResponse)
+	err := c.cc.Invoke(ctx, BookAPI_SearchBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBooksRequest, ImportBooksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookAPI_ServiceDesc.Streams[1], BookAPI_ImportBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportBooksRequest, ImportBooksResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_ImportBooksClient = grpc.ClientStreamingClient[ImportBooksRequest, ImportBooksResponse]
+
+func (c *bookAPIClient) ExportBooks(ctx context.Context, in *ExportBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportBooksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookAPI_ServiceDesc.Streams[2], BookAPI_ExportBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportBooksRequest, ExportBooksResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_ExportBooksClient = grpc.ServerStreamingClient[ExportBooksResponse]
+
+func (c *bookAPIClient) AddAuthor(ctx context.Context, in *AddAuthorRequest, opts ...grpc.CallOption) (*AddAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAuthorResponse)
+	err := c.cc.Invoke(ctx, BookAPI_AddAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, BookAPI_GetAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorsResponse)
+	err := c.cc.Invoke(ctx, BookAPI_ListAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAuthorsResponse)
+	err := c.cc.Invoke(ctx, BookAPI_MergeAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookAPIClient) Registration(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*RegistrationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegistrationResponse)
@@ -119,17 +332,91 @@ func (c *bookAPIClient) Login(ctx context.Context, in *LoginRequest, opts ...grp
 	return out, nil
 }
 
+func (c *bookAPIClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, BookAPI_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, BookAPI_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, BookAPI_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAPIClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, BookAPI_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookAPIServer is the server API for BookAPI service.
 // All implementations should embed UnimplementedBookAPIServer
 // for forward compatibility.
 type BookAPIServer interface {
 	AddBook(context.Context, *AddBookRequest) (*AddBookResponse, error)
 	GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error)
+	GetBookByIsbn(context.Context, *GetBookByIsbnRequest) (*GetBookByIsbnResponse, error)
+	// Ищет метаданные книги во внешних каталогах, ничего не сохраняя.
+	EnrichBook(context.Context, *EnrichBookRequest) (*EnrichBookResponse, error)
+	// Книга попадает в корзину и через trash retention удаляется насовсем.
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
+	// Книги в корзине: свои, у администратора — все.
+	ListDeletedBooks(context.Context, *ListDeletedBooksRequest) (*ListDeletedBooksResponse, error)
+	UndeleteBook(context.Context, *UndeleteBookRequest) (*UndeleteBookResponse, error)
+	// Без update_mask заменяет все поля, с ним — только перечисленные.
+	// PATCH меняет только поля, переданные в теле: из них gateway
+	// составляет update_mask.
 	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
+	// История изменений книги, от старых к новым. Книги из корзины тоже.
+	ListBookRevisions(context.Context, *ListBookRevisionsRequest) (*ListBookRevisionsResponse, error)
+	// Возвращает поля и авторов книги к состоянию после ревизии.
+	// Сам откат тоже попадает в историю.
+	RevertBookToRevision(context.Context, *RevertBookToRevisionRequest) (*RevertBookToRevisionResponse, error)
 	AllBooks(context.Context, *AllBooksRequests) (*AllBooksResponse, error)
+	// Все книги под фильтром по одной, без страниц. Gateway отдаёт
+	// NDJSON: строка {"result": книга} на каждую книгу.
+	StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[Book]) error
+	SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error)
+	// Импорт книг из CSV или JSON Lines. Первое сообщение несёт options,
+	// следующие — файл кусками подряд. Через gateway: POST /books:import.
+	ImportBooks(grpc.ClientStreamingServer[ImportBooksRequest, ImportBooksResponse]) error
+	// Выгрузка каталога в csv, jsonl, bibtex или marcxml кусками файла.
+	// Через gateway: GET /books:export?format=csv.
+	ExportBooks(*ExportBooksRequest, grpc.ServerStreamingServer[ExportBooksResponse]) error
+	AddAuthor(context.Context, *AddAuthorRequest) (*AddAuthorResponse, error)
+	GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error)
+	ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
+	// Переносит книги source_ids на target_id и удаляет source_ids. Только для администраторов.
+	MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error)
 	Registration(context.Context, *RegistrationRequest) (*RegistrationResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
 }
 
 // UnimplementedBookAPIServer should be embedded to have
@@ -145,21 +432,75 @@ func (UnimplementedBookAPIServer) AddBook(context.Context, *AddBookRequest) (*Ad
 func (UnimplementedBookAPIServer) GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBook not implemented")
 }
+func (UnimplementedBookAPIServer) GetBookByIsbn(context.Context, *GetBookByIsbnRequest) (*GetBookByIsbnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookByIsbn not implemented")
+}
+func (UnimplementedBookAPIServer) EnrichBook(context.Context, *EnrichBookRequest) (*EnrichBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrichBook not implemented")
+}
 func (UnimplementedBookAPIServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
+func (UnimplementedBookAPIServer) ListDeletedBooks(context.Context, *ListDeletedBooksRequest) (*ListDeletedBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedBooks not implemented")
+}
+func (UnimplementedBookAPIServer) UndeleteBook(context.Context, *UndeleteBookRequest) (*UndeleteBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteBook not implemented")
+}
 func (UnimplementedBookAPIServer) UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
 }
+func (UnimplementedBookAPIServer) ListBookRevisions(context.Context, *ListBookRevisionsRequest) (*ListBookRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookRevisions not implemented")
+}
+func (UnimplementedBookAPIServer) RevertBookToRevision(context.Context, *RevertBookToRevisionRequest) (*RevertBookToRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertBookToRevision not implemented")
+}
 func (UnimplementedBookAPIServer) AllBooks(context.Context, *AllBooksRequests) (*AllBooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBooks not implemented")
 }
+func (UnimplementedBookAPIServer) StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[Book]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBooks not implemented")
+}
+func (UnimplementedBookAPIServer) SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBooks not implemented")
+}
+func (UnimplementedBookAPIServer) ImportBooks(grpc.ClientStreamingServer[ImportBooksRequest, ImportBooksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBooks not implemented")
+}
+func (UnimplementedBookAPIServer) ExportBooks(*ExportBooksRequest, grpc.ServerStreamingServer[ExportBooksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBooks not implemented")
+}
+func (UnimplementedBookAPIServer) AddAuthor(context.Context, *AddAuthorRequest) (*AddAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthor not implemented")
+}
+func (UnimplementedBookAPIServer) GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
+func (UnimplementedBookAPIServer) ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
+}
+func (UnimplementedBookAPIServer) MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAuthors not implemented")
+}
 func (UnimplementedBookAPIServer) Registration(context.Context, *RegistrationRequest) (*RegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Registration not implemented")
 }
 func (UnimplementedBookAPIServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedBookAPIServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedBookAPIServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBookAPIServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedBookAPIServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
 func (UnimplementedBookAPIServer) testEmbeddedByValue() {}
 
 // UnsafeBookAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +557,42 @@ func _BookAPI_GetBook_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAPI_GetBookByIsbn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByIsbnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).GetBookByIsbn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_GetBookByIsbn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).GetBookByIsbn(ctx, req.(*GetBookByIsbnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_EnrichBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrichBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).EnrichBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_EnrichBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).EnrichBook(ctx, req.(*EnrichBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookAPI_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteBookRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +611,42 @@ func _BookAPI_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAPI_ListDeletedBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).ListDeletedBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_ListDeletedBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).ListDeletedBooks(ctx, req.(*ListDeletedBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_UndeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).UndeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_UndeleteBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).UndeleteBook(ctx, req.(*UndeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookAPI_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateBookRequest)
 	if err := dec(in); err != nil {
@@ -252,6 +665,42 @@ func _BookAPI_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAPI_ListBookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).ListBookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_ListBookRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).ListBookRevisions(ctx, req.(*ListBookRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_RevertBookToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertBookToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).RevertBookToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_RevertBookToRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).RevertBookToRevision(ctx, req.(*RevertBookToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookAPI_AllBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllBooksRequests)
 	if err := dec(in); err != nil {
@@ -270,6 +719,125 @@ func _BookAPI_AllBooks_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAPI_StreamBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookAPIServer).StreamBooks(m, &grpc.GenericServerStream[StreamBooksRequest, Book]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_StreamBooksServer = grpc.ServerStreamingServer[Book]
+
+func _BookAPI_SearchBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).SearchBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_SearchBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).SearchBooks(ctx, req.(*SearchBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_ImportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BookAPIServer).ImportBooks(&grpc.GenericServerStream[ImportBooksRequest, ImportBooksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_ImportBooksServer = grpc.ClientStreamingServer[ImportBooksRequest, ImportBooksResponse]
+
+func _BookAPI_ExportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookAPIServer).ExportBooks(m, &grpc.GenericServerStream[ExportBooksRequest, ExportBooksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookAPI_ExportBooksServer = grpc.ServerStreamingServer[ExportBooksResponse]
+
+func _BookAPI_AddAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).AddAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_AddAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).AddAuthor(ctx, req.(*AddAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_GetAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_ListAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).ListAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_ListAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).ListAuthors(ctx, req.(*ListAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_MergeAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).MergeAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_MergeAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).MergeAuthors(ctx, req.(*MergeAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookAPI_Registration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegistrationRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +874,78 @@ func _BookAPI_Login_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAPI_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAPI_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAPIServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAPI_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAPIServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookAPI_ServiceDesc is the grpc.ServiceDesc for BookAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,18 +961,62 @@ var BookAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBook",
 			Handler:    _BookAPI_GetBook_Handler,
 		},
+		{
+			MethodName: "GetBookByIsbn",
+			Handler:    _BookAPI_GetBookByIsbn_Handler,
+		},
+		{
+			MethodName: "EnrichBook",
+			Handler:    _BookAPI_EnrichBook_Handler,
+		},
 		{
 			MethodName: "DeleteBook",
 			Handler:    _BookAPI_DeleteBook_Handler,
 		},
+		{
+			MethodName: "ListDeletedBooks",
+			Handler:    _BookAPI_ListDeletedBooks_Handler,
+		},
+		{
+			MethodName: "UndeleteBook",
+			Handler:    _BookAPI_UndeleteBook_Handler,
+		},
 		{
 			MethodName: "UpdateBook",
 			Handler:    _BookAPI_UpdateBook_Handler,
 		},
+		{
+			MethodName: "ListBookRevisions",
+			Handler:    _BookAPI_ListBookRevisions_Handler,
+		},
+		{
+			MethodName: "RevertBookToRevision",
+			Handler:    _BookAPI_RevertBookToRevision_Handler,
+		},
 		{
 			MethodName: "AllBooks",
 			Handler:    _BookAPI_AllBooks_Handler,
 		},
+		{
+			MethodName: "SearchBooks",
+			Handler:    _BookAPI_SearchBooks_Handler,
+		},
+		{
+			MethodName: "AddAuthor",
+			Handler:    _BookAPI_AddAuthor_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _BookAPI_GetAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthors",
+			Handler:    _BookAPI_ListAuthors_Handler,
+		},
+		{
+			MethodName: "MergeAuthors",
+			Handler:    _BookAPI_MergeAuthors_Handler,
+		},
 		{
 			MethodName: "Registration",
 			Handler:    _BookAPI_Registration_Handler,
@@ -341,7 +1025,39 @@ var BookAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _BookAPI_Login_Handler,
 		},
+		{
+			MethodName: "Logout",
+			Handler:    _BookAPI_Logout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _BookAPI_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _BookAPI_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _BookAPI_RevokeAllSessions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBooks",
+			Handler:       _BookAPI_StreamBooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBooks",
+			Handler:       _BookAPI_ImportBooks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportBooks",
+			Handler:       _BookAPI_ExportBooks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/v1/service.proto",
 }
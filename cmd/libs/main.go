@@ -3,34 +3,95 @@ package main
 import (
 	pb "bookserver_git/api/proto/v1"
 	"bookserver_git/internal/api"
+	"bookserver_git/internal/config"
 	"bookserver_git/internal/db"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/health"
+	"bookserver_git/internal/memory"
+	"bookserver_git/internal/metadata"
+	"bookserver_git/internal/password"
+	"bookserver_git/internal/sqlite"
+	"bookserver_git/internal/worker"
 	"context"
 	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"gopkg.in/yaml.v3"
+	grpc_health "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	_ "github.com/lib/pq"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
-type Config struct {
-	DSN      string `yaml:"dsn"`
-	LogLevel int    `yaml:"log_level"`
-	Host     string `yaml:"host"`
-	HostGRPC string `yaml:"host_grpc"`
+// migrateUp applies the migrations from source to the database, having no
+// migrations left to apply is not an error. The migrator stays open for
+// migrationsCheck.
+func migrateUp(source, dsn string) (*migrate.Migrate, error) {
+	migrator, err := migrate.New(source, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.Up(); err != nil && err != migrate.ErrNoChange {
+		migrator.Close()
+		return nil, err
+	}
+	return migrator, nil
+}
+
+// migrationsCheck fails when the schema is dirty or no longer at the version
+// it was migrated to at start, e.g. after a failed or rolled back migration.
+func migrationsCheck(migrator *migrate.Migrate) (health.Check, error) {
+	want, _, err := migrator.Version()
+	if err != nil {
+		return nil, err
+	}
+	return func(context.Context) error {
+		version, dirty, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("schema version %d is dirty", version)
+		}
+		if version != want {
+			return fmt.Errorf("schema version is %d, want %d", version, want)
+		}
+		return nil
+	}, nil
+}
+
+// gracefulStop waits for the running RPCs to finish, those still running when
+// ctx is done are cancelled.
+func gracefulStop(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+		<-stopped
+	}
 }
 
 func main() {
@@ -44,13 +105,10 @@ func main() {
 		),
 	}
 
-	yamlContent, err := os.ReadFile("./config.yml")
-	if err != nil {
-		log.Fatal(err)
-	}
+	configPath := flag.String("config", "config.yml", "path to the YAML config")
+	flag.Parse()
 
-	var systemConfig Config
-	err = yaml.Unmarshal(yamlContent, &systemConfig)
+	systemConfig, err := config.Load(*configPath)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -61,49 +119,155 @@ func main() {
 
 	// r := mux.NewRouter()
 
-	migrator, err := migrate.New(
-		"file://migrations",
-		systemConfig.DSN)
+	sessionTTL := domain.SessionTTL{
+		Absolute: systemConfig.Session.AbsoluteTTL,
+		Idle:     systemConfig.Session.IdleTTL,
+	}
+	var repo api.Repository
+	var rowSQLConn *sql.DB
+	var migrator *migrate.Migrate
+	switch systemConfig.Driver {
+	case "postgres":
+		migrator, err = migrateUp("file://"+systemConfig.MigrationsDir, systemConfig.DSN)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		rowSQLConn, err = sql.Open("postgres", systemConfig.DSN)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		repo = db.NewRepository(rowSQLConn, sessionTTL)
+	case "sqlite":
+		// Для sqlite dsn — путь к файлу базы.
+		migrator, err = migrateUp("file://"+systemConfig.MigrationsDir, "sqlite://"+systemConfig.DSN)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		rowSQLConn, err = sqlite.Open(systemConfig.DSN)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		repo = sqlite.NewRepository(rowSQLConn, sessionTTL)
+	case "memory":
+		repo = memory.NewRepository(sessionTTL)
+	}
+	// r.Use(api.Logging(log))
+
+	hasher, err := password.New(systemConfig.Password)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if err := migrator.Up(); err != nil && err != migrate.ErrNoChange { // migrate.ErrNoChange
+
+	trustedProxies, err := api.ParseTrustedProxies(systemConfig.TrustedProxies)
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	rowSQLConn, err := sql.Open("postgres", systemConfig.DSN)
+	enricher, err := metadata.New(systemConfig.Metadata)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	repo := db.NewRepository(rowSQLConn)
-	// r.Use(api.Logging(log))
-
 	ourServer := api.Server{
-		Database: repo,
+		Database:       repo,
+		Passwords:      hasher,
+		TrustedProxies: trustedProxies,
+		Metadata:       enricher,
 	}
 
+	// Хранилищу в памяти проверять нечего, оно готово всегда.
+	checks := make(map[string]health.Check)
+	if rowSQLConn != nil {
+		checks["database"] = rowSQLConn.PingContext
+	}
+	if migrator != nil {
+		checks["migrations"], err = migrationsCheck(migrator)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	healthServer := grpc_health.NewServer()
+	// До первой проверки сервер не готов.
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	checker := health.NewChecker(healthServer, systemConfig.Health.CheckTimeout, checks)
+
+	// SIGINT или SIGTERM отменяет ctx, как и падение любой из горутин группы.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	group, ctx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		worker.Every(ctx, log, "session sweeper", systemConfig.Session.SweepInterval, func(ctx context.Context) error {
+			deleted, err := repo.DeleteExpiredSessions(ctx)
+			if err != nil {
+				return err
+			}
+			log.Debug("Expired sessions deleted", slog.Int64("count", deleted))
+			return nil
+		})
+		return nil
+	})
+	group.Go(func() error {
+		checker.Run(ctx, log, systemConfig.Health.CheckInterval)
+		return nil
+	})
+	// retention 0 — книги лежат в корзине без срока, чистить нечего.
+	purgeInterval := systemConfig.Trash.PurgeInterval
+	if systemConfig.Trash.Retention == 0 {
+		purgeInterval = 0
+	}
+	group.Go(func() error {
+		worker.Every(ctx, log, "trash purge", purgeInterval, func(ctx context.Context) error {
+			purged, err := repo.PurgeDeletedBooks(ctx, systemConfig.Trash.Retention)
+			if err != nil {
+				return err
+			}
+			log.Debug("Deleted books purged", slog.Int64("count", purged))
+			return nil
+		})
+		return nil
+	})
+
 	ln, err := net.Listen("tcp", systemConfig.HostGRPC)
 	if err != nil {
 		fmt.Println(err)
+		os.Exit(1)
 	}
 	server := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(
 			// Order matters e.g. tracing interceptor have to create span first for the later exemplars to work.
 			logging.UnaryServerInterceptor(interceptorLogger(log), loggingOpts...),
+			api.UnaryErrorInterceptor(log),
+			ourServer.UnaryAuthInterceptor(),
+			api.UnaryValidateInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(interceptorLogger(log), loggingOpts...),
+			api.StreamErrorInterceptor(log),
+			ourServer.StreamAuthInterceptor(),
+			api.StreamValidateInterceptor(),
 		),
 	)
 	pb.RegisterBookAPIServer(server, &ourServer)
+	healthpb.RegisterHealthServer(server, healthServer)
 
-	go func() {
-		if err = server.Serve(ln); err != nil {
-			fmt.Println(err)
-		}
-	}()
+	group.Go(func() error {
+		// После GracefulStop Serve возвращает nil.
+		return server.Serve(ln)
+	})
 
 	conn, err := grpc.NewClient(systemConfig.HostGRPC,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -111,14 +275,34 @@ func main() {
 	if err != nil {
 		fmt.Println(err)
 	}
-	defer conn.Close()
 
-	gw := grpc_run.NewServeMux()
+	gw := grpc_run.NewServeMux(
+		grpc_run.WithIncomingHeaderMatcher(api.GatewayHeaderMatcher),
+		grpc_run.WithOutgoingHeaderMatcher(api.GatewayOutgoingHeaderMatcher),
+		grpc_run.WithErrorHandler(api.GatewayErrorHandler),
+		grpc_run.WithMarshalerOption(grpc_run.MIMEWildcard, api.GatewayMarshaler()),
+	)
 
 	err = pb.RegisterBookAPIHandler(context.TODO(), gw, conn)
 	if err != nil {
 		fmt.Println(err)
 	}
+	client := pb.NewBookAPIClient(conn)
+	err = gw.HandlePath(http.MethodPost, "/books:import", api.ImportBooksHandler(gw, client))
+	if err != nil {
+		fmt.Println(err)
+	}
+	err = gw.HandlePath(http.MethodGet, "/books:export", api.ExportBooksHandler(gw, client, log))
+	if err != nil {
+		fmt.Println(err)
+	}
+	err = errors.Join(
+		gw.HandlePath(http.MethodGet, "/healthz", api.HealthzHandler(gw)),
+		gw.HandlePath(http.MethodGet, "/readyz", api.ReadyzHandler(gw, healthpb.NewHealthClient(conn))),
+	)
+	if err != nil {
+		fmt.Println(err)
+	}
 	gwServer := &http.Server{
 		Addr:    systemConfig.Host,
 		Handler: gw,
@@ -131,10 +315,40 @@ func main() {
 	// r.HandleFunc("/books", ourServer.AllBooks).Methods(http.MethodGet)
 
 	log.Warn("Server started")
-	err = gwServer.ListenAndServe()
+	group.Go(func() error {
+		if err := gwServer.ListenAndServe(); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	})
+	group.Go(func() error {
+		<-ctx.Done()
+		log.Warn("Server stopping")
+		// Пока запросы дорабатывают, новых пусть не присылают.
+		healthServer.Shutdown()
+
+		// Сначала gateway: его запросы сами идут в gRPC-сервер.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), systemConfig.ShutdownTimeout)
+		defer cancel()
+		err := gwServer.Shutdown(shutdownCtx)
+		gracefulStop(shutdownCtx, server)
+		return err
+	})
+
+	err = group.Wait()
+	// Воркеры и серверы уже остановлены, соединения больше никому не нужны.
+	conn.Close()
+	if migrator != nil {
+		migrator.Close()
+	}
+	if rowSQLConn != nil {
+		rowSQLConn.Close()
+	}
 	if err != nil {
-		log.Debug("Server failed")
+		log.Error("Server failed", slog.Any("error", err))
+		os.Exit(1)
 	}
+	log.Warn("Server stopped")
 }
 
 func interceptorLogger(l *slog.Logger) logging.Logger {
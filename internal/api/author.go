@@ -0,0 +1,112 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"slices"
+)
+
+func (s Server) AddAuthor(ctx context.Context, request *pb.AddAuthorRequest) (*pb.AddAuthorResponse, error) {
+	author, err := s.Database.SaveAuthor(ctx, domain.Author{Name: request.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddAuthorResponse{Author: toAuthor(author)}, nil
+}
+
+func (s Server) GetAuthor(ctx context.Context, request *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error) {
+	author, err := s.Database.GetAuthor(ctx, int(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAuthorResponse{Author: toAuthor(author)}, nil
+}
+
+func (s Server) ListAuthors(ctx context.Context, request *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error) {
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	afterID, err := decodeOffsetToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, err := s.Database.ListAuthors(ctx, request.NameContains, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if len(authors) > pageSize {
+		authors = authors[:pageSize]
+		nextPageToken = encodeOffsetToken(authors[len(authors)-1].ID)
+	}
+
+	pbAuthors := make([]*pb.Author, len(authors))
+	for i := range authors {
+		pbAuthors[i] = toAuthor(authors[i])
+	}
+	return &pb.ListAuthorsResponse{Authors: pbAuthors, NextPageToken: nextPageToken}, nil
+}
+
+func (s Server) MergeAuthors(ctx context.Context, request *pb.MergeAuthorsRequest) (*pb.MergeAuthorsResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.IsAdmin {
+		return nil, errs.PermissionDenied("author", request.TargetId, "only administrators can merge authors")
+	}
+	if slices.Contains(request.SourceIds, request.TargetId) {
+		return nil, errs.InvalidArgument("invalid source_ids",
+			errs.FieldViolation{Field: "source_ids", Description: "must not contain target_id"})
+	}
+
+	sourceIDs := make([]int, len(request.SourceIds))
+	for i, id := range request.SourceIds {
+		sourceIDs[i] = int(id)
+	}
+	author, err := s.Database.MergeAuthors(ctx, int(request.TargetId), sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MergeAuthorsResponse{Author: toAuthor(author)}, nil
+}
+
+// resolveAuthors turns author IDs and names of a request into the authors for
+// domain.Book.Authors, creating authors that do not exist yet. Only the IDs
+// are filled in, duplicates are dropped.
+func resolveAuthors(ctx context.Context, repo Repository, ids []int64, names []string) ([]domain.Author, error) {
+	result := make([]domain.Author, 0, len(ids)+len(names))
+	add := func(id int) {
+		if !slices.ContainsFunc(result, func(a domain.Author) bool { return a.ID == id }) {
+			result = append(result, domain.Author{ID: id})
+		}
+	}
+	for _, id := range ids {
+		add(int(id))
+	}
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	authors, err := repo.EnsureAuthors(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, author := range authors {
+		add(author.ID)
+	}
+	return result, nil
+}
+
+func toAuthor(u domain.Author) *pb.Author {
+	return &pb.Author{
+		Id:   int64(u.ID),
+		Name: u.Name,
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/errs"
+	bookmeta "bookserver_git/internal/metadata"
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s Server) EnrichBook(ctx context.Context, request *pb.EnrichBookRequest) (*pb.EnrichBookResponse, error) {
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	found, sources, err := s.lookupMetadata(ctx, isbn13)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make([]*pb.Author, len(found.Authors))
+	for i, name := range found.Authors {
+		authors[i] = &pb.Author{Name: name}
+	}
+	book := &pb.Book{
+		Title:     found.Title,
+		Year:      int64(found.Year),
+		Authors:   authors,
+		Isbn:      isbn13,
+		Publisher: found.Publisher,
+		CoverUrl:  found.CoverURL,
+	}
+	return &pb.EnrichBookResponse{Book: book, Providers: sources}, nil
+}
+
+func (s Server) lookupMetadata(ctx context.Context, isbn13 string) (bookmeta.Metadata, []string, error) {
+	if s.Metadata == nil {
+		return bookmeta.Metadata{}, nil, status.Error(codes.Unimplemented, "metadata providers are not configured")
+	}
+	found, sources, err := s.Metadata.Lookup(ctx, isbn13)
+	switch {
+	case errors.Is(err, bookmeta.ErrNotFound):
+		return bookmeta.Metadata{}, nil, errs.NotFound("isbn", isbn13)
+	case errors.Is(err, bookmeta.ErrUnavailable):
+		return bookmeta.Metadata{}, nil, status.Error(codes.Unavailable, "metadata providers are unavailable")
+	case err != nil:
+		return bookmeta.Metadata{}, nil, err
+	}
+	return found, sources, nil
+}
+
+// maxTitleLen is max_len of AddBookRequest.title in service.proto.
+const maxTitleLen = 32
+
+// enrichAddRequest returns a copy of the request with the empty fields filled
+// from the metadata providers. Fields sent by the client always win.
+//
+// Catalog values that break the rules of AddBookRequest are left out, the
+// client is not to blame for them. Only a long title is cut to fit: without a
+// title the book cannot be saved.
+func (s Server) enrichAddRequest(ctx context.Context, request *pb.AddBookRequest, isbn13 string) (*pb.AddBookRequest, error) {
+	if isbn13 == "" {
+		return nil, errs.InvalidArgument("isbn is required to enrich a book",
+			errs.FieldViolation{Field: "isbn", Description: "value is required when enrich is set"})
+	}
+	found, _, err := s.lookupMetadata(ctx, isbn13)
+	if err != nil {
+		return nil, err
+	}
+
+	result := proto.Clone(request).(*pb.AddBookRequest)
+	if result.Title == "" {
+		title := truncateRunes(strings.TrimSpace(found.Title), maxTitleLen)
+		if catalogFits(&pb.AddBookRequest{Title: title}) {
+			result.Title = title
+		}
+	}
+	if result.Year == 0 && catalogFits(&pb.AddBookRequest{Year: int64(found.Year)}) {
+		result.Year = int64(found.Year)
+	}
+	if len(result.AuthorIds) == 0 && len(result.AuthorNames) == 0 {
+		for _, name := range found.Authors {
+			if catalogFits(&pb.AddBookRequest{AuthorNames: []string{name}}) {
+				result.AuthorNames = append(result.AuthorNames, name)
+			}
+		}
+	}
+	if result.Publisher == "" && catalogFits(&pb.AddBookRequest{Publisher: found.Publisher}) {
+		result.Publisher = found.Publisher
+	}
+	if result.CoverUrl == "" && catalogFits(&pb.AddBookRequest{CoverUrl: found.CoverURL}) {
+		result.CoverUrl = found.CoverURL
+	}
+	return result, nil
+}
+
+// catalogFits reports whether the only field set in probe passes the rules.
+func catalogFits(probe *pb.AddBookRequest) bool {
+	return probe.ValidateAll() == nil
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:n]))
+}
@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/memory"
+	bookmeta "bookserver_git/internal/metadata"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Catalog data that breaks the rules of AddBookRequest does not fail AddBook.
+func TestAddBookEnrichInvalidCatalogData(t *testing.T) {
+	catalog := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ISBN:9780747532699": {
+			"title": "Harry Potter and the Philosopher's Stone",
+			"publish_date": "1997",
+			"authors": [{"name": "J. K. Rowling"}, {"name": "` + strings.Repeat("x", 150) + `"}],
+			"publishers": [{"name": "Bloomsbury"}],
+			"cover": {"large": "not a url"}
+		}}`))
+	}))
+	defer catalog.Close()
+	enricher, err := bookmeta.New(bookmeta.Config{Providers: []bookmeta.ProviderConfig{
+		{Name: bookmeta.ProviderOpenLibrary, BaseURL: catalog.URL},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := pb.NewBookAPIClient(serve(t, api.Server{
+		Database: memory.NewRepository(domain.SessionTTL{}),
+		Metadata: enricher,
+	}))
+
+	response, err := client.AddBook(context.Background(), &pb.AddBookRequest{Isbn: "0-7475-3269-9", Enrich: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := response.Book
+	if book.Title != "Harry Potter and the Philosopher" {
+		t.Errorf("title %q, want it cut to 32 characters", book.Title)
+	}
+	if book.Year != 1997 || book.Publisher != "Bloomsbury" {
+		t.Errorf("year %d, publisher %q, want 1997, Bloomsbury", book.Year, book.Publisher)
+	}
+	var names []string
+	for _, author := range book.Authors {
+		names = append(names, author.Name)
+	}
+	if want := []string{"J. K. Rowling"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("authors %v, want %v", names, want)
+	}
+	if book.CoverUrl != "" {
+		t.Errorf("cover %q, want the invalid URL left out", book.CoverUrl)
+	}
+}
@@ -1,23 +1,75 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func handleError(w http.ResponseWriter, status int, err error) {
-	result := map[string]interface{}{
-		"error":  err.Error(),
-		"status": http.StatusText(status),
+// errorEnvelope is the JSON body of every error answered by the gateway.
+type errorEnvelope struct {
+	Error   string            `json:"error"`
+	Status  string            `json:"status"`
+	Code    string            `json:"code,omitempty"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// GatewayErrorHandler is plugged into the gateway with runtime.WithErrorHandler.
+func GatewayErrorHandler(ctx context.Context, mux *grpc_run.ServeMux, marshaler grpc_run.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := 0
+	var customStatus *grpc_run.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+		httpStatus = customStatus.HTTPStatus
+	}
+
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = grpc_run.HTTPStatusFromCode(st.Code())
+	}
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", authScheme)
+	}
+	// Aborted у нас бывает только при несовпавшем etag / If-Match.
+	if st.Code() == codes.Aborted && customStatus == nil {
+		httpStatus = http.StatusPreconditionFailed
+	}
+	handleError(w, httpStatus, st.Err())
+}
+
+func handleError(w http.ResponseWriter, httpStatus int, err error) {
+	result := errorEnvelope{
+		Error:  err.Error(),
+		Status: http.StatusText(httpStatus),
+	}
+	if st, ok := status.FromError(err); ok {
+		result.Error = st.Message()
+		result.Code = st.Code().String()
+		for _, detail := range st.Proto().GetDetails() {
+			data, err := protojson.Marshal(detail)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			result.Details = append(result.Details, data)
+		}
 	}
+
 	data, err := json.Marshal(result)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Println(err)
 		return
 	}
-	w.WriteHeader(status)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
 	_, err = w.Write(data)
 	if err != nil {
 		fmt.Println(err)
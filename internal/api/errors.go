@@ -0,0 +1,92 @@
+package api
+
+import (
+	"bookserver_git/internal/errs"
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// UnaryErrorInterceptor converts errs.Error returned by handlers into gRPC
+// statuses with details. Any other error is logged and hidden behind Internal.
+func UnaryErrorInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(ctx, log, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func StreamErrorInterceptor(log *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			return toStatus(ss.Context(), log, info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+var kindCodes = map[errs.Kind]codes.Code{
+	errs.KindNotFound:         codes.NotFound,
+	errs.KindAlreadyExists:    codes.AlreadyExists,
+	errs.KindInvalidArgument:  codes.InvalidArgument,
+	errs.KindUnauthenticated:  codes.Unauthenticated,
+	errs.KindPermissionDenied: codes.PermissionDenied,
+	errs.KindAborted:          codes.Aborted,
+}
+
+func toStatus(ctx context.Context, log *slog.Logger, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var e *errs.Error
+	if !errors.As(err, &e) || e.Kind == errs.KindInternal {
+		log.ErrorContext(ctx, "Internal error", slog.String("method", method), slog.Any("error", err))
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	// Причину (e.Err) клиенту не отдаём: там текст ошибки драйвера.
+	message := e.Text()
+	st := status.New(kindCodes[e.Kind], message)
+
+	var details []protoadapt.MessageV1
+	if e.Resource != "" {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: e.Resource,
+			ResourceName: e.ID,
+			Description:  message,
+		})
+	}
+	if len(e.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, f := range e.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.Field,
+				Description: f.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if len(details) == 0 {
+		return st.Err()
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
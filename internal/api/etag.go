@@ -0,0 +1,55 @@
+package api
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// bookETag is a strong ETag built from the book version.
+func bookETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// expectedVersion returns the book version the client expects to change: from
+// the etag field or, for gateway requests, from the If-Match header.
+// 0 means there is no precondition.
+func expectedVersion(ctx context.Context, etag string) (int, error) {
+	field := "etag"
+	if etag == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		etag, field = lastValue(md, "if-match"), "If-Match"
+	}
+	etag = strings.TrimSpace(etag)
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+
+	invalid := errs.InvalidArgument("invalid etag",
+		errs.FieldViolation{Field: field, Description: `must be an ETag of the book like "3"`})
+	if strings.HasPrefix(etag, "W/") {
+		invalid.Fields[0].Description = "weak ETags cannot be used to change a book"
+		return 0, invalid
+	}
+	unquoted, opened := strings.CutPrefix(etag, `"`)
+	unquoted, closed := strings.CutSuffix(unquoted, `"`)
+	if !opened || !closed {
+		return 0, invalid
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return 0, invalid
+	}
+	return version, nil
+}
+
+// setETagHeader sends the ETag of the book in the response headers, the
+// gateway turns it into the ETag HTTP header.
+func setETagHeader(ctx context.Context, book domain.Book) error {
+	return grpc.SetHeader(ctx, metadata.Pairs("etag", bookETag(book.Version)))
+}
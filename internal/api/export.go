@@ -0,0 +1,45 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/export"
+	"bufio"
+	"fmt"
+)
+
+const exportChunkSize = 32 << 10
+
+func (s Server) ExportBooks(request *pb.ExportBooksRequest, stream pb.BookAPI_ExportBooksServer) error {
+	format, ok := export.Lookup(request.Format)
+	if !ok {
+		return fmt.Errorf("export format %q passed validation but is not supported", request.Format)
+	}
+
+	buffered := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	writer, err := format.NewWriter(buffered)
+	if err != nil {
+		return err
+	}
+	filter := bookFilter(request.TitleContains, request.YearMin, request.YearMax, request.OwnerId)
+	err = s.Database.ExportBooks(stream.Context(), filter, writer.WriteBook)
+	if err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return buffered.Flush()
+}
+
+// chunkWriter sends everything written to it as ExportBooksResponse chunks.
+type chunkWriter struct {
+	stream pb.BookAPI_ExportBooksServer
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	// Send сериализует сообщение сразу, так что буфер можно переиспользовать.
+	if err := c.stream.Send(&pb.ExportBooksResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
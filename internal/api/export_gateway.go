@@ -0,0 +1,72 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/export"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportBooksHandler serves GET /books:export?format=... as a file download.
+// The gateway itself would put a delimiter after every chunk of the stream.
+func ExportBooksHandler(mux *grpc_run.ServeMux, client pb.BookAPIClient, log *slog.Logger) grpc_run.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := grpc_run.MarshalerForRequest(mux, r)
+		ctx, err := grpc_run.AnnotateContext(r.Context(), mux, r, pb.BookAPI_ExportBooks_FullMethodName,
+			grpc_run.WithHTTPPathPattern("/books:export"))
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		request := &pb.ExportBooksRequest{}
+		err = grpc_run.PopulateQueryParameters(request, r.URL.Query(), utilities.NewDoubleArray(nil))
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		stream, err := client.ExportBooks(ctx, request)
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// До первого куска ещё можно ответить ошибкой со своим статусом.
+		first, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		format, _ := export.Lookup(request.Format)
+		w.Header().Set("Content-Type", format.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="books.%s"`, format.Extension))
+		w.WriteHeader(http.StatusOK)
+
+		rc := http.NewResponseController(w)
+		for chunk := first; chunk != nil; {
+			if _, err := w.Write(chunk.GetChunk()); err != nil {
+				return
+			}
+			_ = rc.Flush()
+
+			chunk, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				// Статус уже отправлен, клиент должен увидеть оборванный ответ,
+				// а не файл, который выглядит целым.
+				log.Error("export stream failed", slog.String("error", err.Error()))
+				panic(http.ErrAbortHandler)
+			}
+		}
+	}
+}
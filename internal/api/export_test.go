@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/memory"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func exportBooks(t *testing.T, client pb.BookAPIClient, format string) []byte {
+	t.Helper()
+	stream, err := client.ExportBooks(context.Background(), &pb.ExportBooksRequest{Format: format})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file []byte
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return file
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		file = append(file, response.Chunk...)
+	}
+}
+
+func importBooks(t *testing.T, client pb.BookAPIClient, format string, file []byte) *pb.ImportBooksResponse {
+	t.Helper()
+	stream, err := client.ImportBooks(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&pb.ImportBooksRequest{Payload: &pb.ImportBooksRequest_Options{
+		Options: &pb.ImportOptions{Format: format},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&pb.ImportBooksRequest{Payload: &pb.ImportBooksRequest_Chunk{Chunk: file}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return report
+}
+
+// An exported file imported into an empty catalog gives the same export back.
+func TestExportImportRoundTrip(t *testing.T) {
+	books := []*pb.AddBookRequest{
+		{
+			Title:       "Dune",
+			Year:        1965,
+			AuthorNames: []string{"Frank Herbert"},
+			Isbn:        "9780441013593",
+			Publisher:   "Chilton",
+			CoverUrl:    "https://covers.example/dune.jpg",
+		},
+		{
+			Title:       "Good Omens",
+			Year:        1990,
+			AuthorNames: []string{"Terry Pratchett", "Neil Gaiman"},
+			Edition:     "1st",
+		},
+		{Title: "Untitled draft"},
+	}
+
+	for _, format := range []string{"jsonl", "csv"} {
+		t.Run(format, func(t *testing.T) {
+			from := pb.NewBookAPIClient(serve(t, api.Server{Database: memory.NewRepository(domain.SessionTTL{})}))
+			for _, book := range books {
+				if _, err := from.AddBook(context.Background(), book); err != nil {
+					t.Fatal(err)
+				}
+			}
+			file := exportBooks(t, from, format)
+
+			to := pb.NewBookAPIClient(serve(t, api.Server{Database: memory.NewRepository(domain.SessionTTL{})}))
+			report := importBooks(t, to, format, file)
+			if report.Created != int32(len(books)) || report.Failed != 0 {
+				t.Fatalf("import: %v", report)
+			}
+			if again := exportBooks(t, to, format); !bytes.Equal(again, file) {
+				t.Errorf("export after import:\n%s\nwant:\n%s", again, file)
+			}
+		})
+	}
+}
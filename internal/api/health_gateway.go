@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthzHandler serves GET /healthz: the process is alive as long as it
+// answers at all.
+func HealthzHandler(mux *grpc_run.ServeMux) grpc_run.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		writeHealth(mux, w, r, http.StatusOK, healthpb.HealthCheckResponse_SERVING)
+	}
+}
+
+// ReadyzHandler serves GET /readyz with the grpc.health.v1 status of the
+// server, 503 unless it is SERVING. Going through gRPC also checks that the
+// gateway reaches the server.
+func ReadyzHandler(mux *grpc_run.ServeMux, client healthpb.HealthClient) grpc_run.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		response, err := client.Check(r.Context(), &healthpb.HealthCheckRequest{})
+		if err != nil {
+			_, outbound := grpc_run.MarshalerForRequest(mux, r)
+			grpc_run.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		code := http.StatusOK
+		if response.Status != healthpb.HealthCheckResponse_SERVING {
+			code = http.StatusServiceUnavailable
+		}
+		writeHealth(mux, w, r, code, response.Status)
+	}
+}
+
+func writeHealth(mux *grpc_run.ServeMux, w http.ResponseWriter, r *http.Request, code int, status healthpb.HealthCheckResponse_ServingStatus) {
+	_, outbound := grpc_run.MarshalerForRequest(mux, r)
+	body, err := outbound.Marshal(&healthpb.HealthCheckResponse{Status: status})
+	if err != nil {
+		grpc_run.HTTPError(r.Context(), mux, outbound, w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", outbound.ContentType(body))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	w.Write(body)
+}
@@ -0,0 +1,400 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultImportBatchSize  = 100
+	defaultAuthorsSeparator = ";"
+
+	importCreated = "created"
+	importSkipped = "skipped"
+	importFailed  = "failed"
+)
+
+// csvFields are the book fields that can be read from CSV columns.
+var csvFields = []string{"title", "year", "isbn", "edition", "publisher", "cover_url", "authors"}
+
+func (s Server) ImportBooks(stream pb.BookAPI_ImportBooksServer) error {
+	ctx := stream.Context()
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	options := first.GetOptions()
+	if options == nil {
+		return errs.InvalidArgument("import options are required",
+			errs.FieldViolation{Field: "options", Description: "must be sent in the first message"})
+	}
+
+	rows, err := newImportRows(options, &chunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+	batchSize := int(options.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	imp := importer{
+		server: s,
+		userID: caller.UserID,
+		dryRun: options.DryRun,
+		report: &pb.ImportBooksResponse{},
+		seen:   make(map[string]int32),
+	}
+	for {
+		row, err := rows.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		imp.add(ctx, row)
+		if len(imp.batch) >= batchSize {
+			if err := imp.flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	if err := imp.flush(ctx); err != nil {
+		return err
+	}
+	return stream.SendAndClose(imp.report)
+}
+
+// importRow is one line of the file. err is set when the line itself is broken.
+type importRow struct {
+	line    int
+	request *pb.AddBookRequest
+	err     error
+}
+
+type importRows interface {
+	// next returns io.EOF after the last row. Other errors stop the import.
+	next() (importRow, error)
+}
+
+func newImportRows(options *pb.ImportOptions, body io.Reader) (importRows, error) {
+	if options.Format == "jsonl" {
+		return &jsonlRows{r: bufio.NewReader(body)}, nil
+	}
+	return newCSVRows(options, body)
+}
+
+type csvRows struct {
+	r         *csv.Reader
+	index     map[string]int
+	separator string
+}
+
+func newCSVRows(options *pb.ImportOptions, body io.Reader) (*csvRows, error) {
+	r := csv.NewReader(body)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errs.InvalidArgument("csv file is empty")
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, errs.InvalidArgument("invalid csv header: " + parseErr.Err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	index := make(map[string]int, len(csvFields))
+	for _, field := range csvFields {
+		column, explicit := options.Columns[field]
+		if !explicit {
+			column = field
+		}
+		i, ok := positions[strings.ToLower(strings.TrimSpace(column))]
+		if !ok {
+			if explicit {
+				return nil, errs.InvalidArgument("csv column not found", errs.FieldViolation{
+					Field:       "options.columns." + field,
+					Description: fmt.Sprintf("no column %q in the header", column),
+				})
+			}
+			continue
+		}
+		index[field] = i
+	}
+	if _, ok := index["title"]; !ok {
+		return nil, errs.InvalidArgument("csv has no title column")
+	}
+
+	separator := options.AuthorsSeparator
+	if separator == "" {
+		separator = defaultAuthorsSeparator
+	}
+	return &csvRows{r: r, index: index, separator: separator}, nil
+}
+
+func (c *csvRows) next() (importRow, error) {
+	record, err := c.r.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return importRow{line: parseErr.StartLine, err: errs.InvalidArgument("invalid csv: " + parseErr.Err.Error())}, nil
+	}
+	if err != nil {
+		return importRow{}, err
+	}
+	line, _ := c.r.FieldPos(0)
+
+	get := func(field string) string {
+		i, ok := c.index[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	request := &pb.AddBookRequest{
+		Title:     get("title"),
+		Isbn:      get("isbn"),
+		Edition:   get("edition"),
+		Publisher: get("publisher"),
+		CoverUrl:  get("cover_url"),
+	}
+	if year := get("year"); year != "" {
+		request.Year, err = strconv.ParseInt(year, 10, 64)
+		if err != nil {
+			return importRow{line: line, err: errs.InvalidArgument("invalid year",
+				errs.FieldViolation{Field: "year", Description: "must be a number"})}, nil
+		}
+	}
+	for _, name := range strings.Split(get("authors"), c.separator) {
+		if name = strings.TrimSpace(name); name != "" {
+			request.AuthorNames = append(request.AuthorNames, name)
+		}
+	}
+	return importRow{line: line, request: request}, nil
+}
+
+// jsonlRows reads one AddBookRequest in JSON per line, empty lines are skipped.
+// Lines of the jsonl export are read too: fields AddBookRequest has no place
+// for, like id, are ignored and authors[].name become author_names.
+type jsonlRows struct {
+	r    *bufio.Reader
+	line int
+}
+
+func (j *jsonlRows) next() (importRow, error) {
+	for {
+		data, err := j.r.ReadBytes('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || len(data) == 0) {
+			return importRow{}, err
+		}
+		j.line++
+		if j.line == 1 {
+			data = bytes.TrimPrefix(data, []byte("\ufeff"))
+		}
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		request := &pb.AddBookRequest{}
+		if err := jsonlUnmarshal.Unmarshal(data, request); err != nil {
+			return importRow{line: j.line, err: errs.InvalidArgument("invalid json: " + err.Error())}, nil
+		}
+		var exported struct {
+			Authors []struct {
+				Name string `json:"name"`
+			} `json:"authors"`
+		}
+		if err := json.Unmarshal(data, &exported); err != nil {
+			return importRow{line: j.line, err: errs.InvalidArgument("invalid json: authors: " + err.Error())}, nil
+		}
+		for _, author := range exported.Authors {
+			request.AuthorNames = append(request.AuthorNames, author.Name)
+		}
+		return importRow{line: j.line, request: request}, nil
+	}
+}
+
+var jsonlUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// chunkReader glues the chunks of the import stream into one file.
+type chunkReader struct {
+	stream pb.BookAPI_ImportBooksServer
+	buf    []byte
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		message, err := c.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if message.GetOptions() != nil {
+			return 0, errs.InvalidArgument("import options must be sent only once")
+		}
+		c.buf = message.GetChunk()
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+type importer struct {
+	server Server
+	userID int
+	dryRun bool
+	report *pb.ImportBooksResponse
+
+	batch     []domain.Book
+	batchRows []*pb.ImportRowResult
+	// seen помнит первую строку каждой книги, чтобы повтор в файле был skipped
+	// и при dry run, когда предыдущие пачки не сохранены.
+	seen map[string]int32
+}
+
+func (imp *importer) add(ctx context.Context, row importRow) {
+	result := &pb.ImportRowResult{Line: int32(row.line)}
+	imp.report.Rows = append(imp.report.Rows, result)
+
+	book, err := imp.prepare(ctx, row)
+	if err != nil {
+		imp.fail(result, err)
+		return
+	}
+	key := "title:" + book.Title + "\x00" + book.Edition
+	if book.ISBN != "" {
+		key = "isbn:" + book.ISBN
+	}
+	if first, ok := imp.seen[key]; ok {
+		imp.skip(result, fmt.Sprintf("duplicate of line %d", first))
+		return
+	}
+	imp.seen[key] = result.Line
+
+	imp.batch = append(imp.batch, book)
+	imp.batchRows = append(imp.batchRows, result)
+}
+
+func (imp *importer) prepare(ctx context.Context, row importRow) (domain.Book, error) {
+	if row.err != nil {
+		return domain.Book{}, row.err
+	}
+	request := row.request
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	if request.Enrich {
+		request, err = imp.server.enrichAddRequest(ctx, request, isbn13)
+	} else {
+		err = validateMessage(request)
+	}
+	if err != nil {
+		return domain.Book{}, err
+	}
+	if request.Title == "" {
+		return domain.Book{}, errs.InvalidArgument("invalid request",
+			errs.FieldViolation{Field: "title", Description: "value is required"})
+	}
+
+	book := domain.Book{
+		Title:     request.Title,
+		Year:      int(request.Year),
+		UserID:    imp.userID,
+		ISBN:      isbn13,
+		Edition:   request.Edition,
+		Publisher: request.Publisher,
+		CoverURL:  request.CoverUrl,
+	}
+	for _, id := range request.AuthorIds {
+		book.Authors = append(book.Authors, domain.Author{ID: int(id)})
+	}
+	for _, name := range request.AuthorNames {
+		book.Authors = append(book.Authors, domain.Author{Name: name})
+	}
+	return book, nil
+}
+
+// flush saves the collected batch in one transaction.
+func (imp *importer) flush(ctx context.Context) error {
+	if len(imp.batch) == 0 {
+		return nil
+	}
+	results, err := imp.server.Database.ImportBooks(ctx, imp.batch, imp.dryRun)
+	if err != nil {
+		return err
+	}
+	for i, saved := range results {
+		row := imp.batchRows[i]
+		switch {
+		case saved.Err != nil:
+			imp.fail(row, saved.Err)
+		case saved.ID == 0:
+			imp.skip(row, "book already exists")
+		default:
+			row.Status = importCreated
+			if !imp.dryRun {
+				row.BookId = int64(saved.ID)
+			}
+			imp.report.Created++
+		}
+	}
+	imp.batch = imp.batch[:0]
+	imp.batchRows = imp.batchRows[:0]
+	return nil
+}
+
+func (imp *importer) skip(row *pb.ImportRowResult, reason string) {
+	row.Status = importSkipped
+	row.Reason = reason
+	imp.report.Skipped++
+}
+
+func (imp *importer) fail(row *pb.ImportRowResult, err error) {
+	row.Status = importFailed
+	row.Reason = importReason(err)
+	imp.report.Failed++
+}
+
+// importReason describes the error of a row the same way the error
+// interceptor would, internal details stay hidden.
+func importReason(err error) string {
+	var e *errs.Error
+	if errors.As(err, &e) && e.Kind != errs.KindInternal {
+		reason := e.Text()
+		for _, field := range e.Fields {
+			reason += "; " + field.Field + ": " + field.Description
+		}
+		return reason
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return "internal error"
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const importChunkSize = 32 << 10
+
+// importFormats maps the Content-Type of the body to ImportOptions.format.
+var importFormats = map[string]string{
+	"text/csv":                 "csv",
+	"application/x-ndjson":     "jsonl",
+	"application/jsonl":        "jsonl",
+	"application/x-jsonlines":  "jsonl",
+	"application/jsonlines":    "jsonl",
+	"application/x-json-lines": "jsonl",
+}
+
+// ImportBooksHandler serves POST /books:import, the gateway cannot map a
+// client stream of chunks itself. The body is the file, the options come from
+// the query: format, dry_run, batch_size, authors_separator and
+// columns[<field>]=<header>. Without format the Content-Type decides.
+func ImportBooksHandler(mux *grpc_run.ServeMux, client pb.BookAPIClient) grpc_run.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := grpc_run.MarshalerForRequest(mux, r)
+		ctx, err := grpc_run.AnnotateContext(r.Context(), mux, r, pb.BookAPI_ImportBooks_FullMethodName,
+			grpc_run.WithHTTPPathPattern("/books:import"))
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		options := &pb.ImportOptions{}
+		err = grpc_run.PopulateQueryParameters(options, r.URL.Query(), utilities.NewDoubleArray(nil))
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		if options.Format == "" {
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			options.Format = importFormats[mediaType]
+		}
+
+		response, err := forwardImport(ctx, client, options, r.Body)
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		data, err := outbound.Marshal(response)
+		if err != nil {
+			grpc_run.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", outbound.ContentType(response))
+		_, _ = w.Write(data)
+	}
+}
+
+func forwardImport(ctx context.Context, client pb.BookAPIClient, options *pb.ImportOptions, body io.Reader) (*pb.ImportBooksResponse, error) {
+	stream, err := client.ImportBooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = stream.Send(&pb.ImportBooksRequest{Payload: &pb.ImportBooksRequest_Options{Options: options}})
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	// io.EOF от Send значит, что сервер уже ответил, ответ отдаст CloseAndRecv.
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		n, readErr := body.Read(buf)
+		if n > 0 {
+			err = stream.Send(&pb.ImportBooksRequest{Payload: &pb.ImportBooksRequest_Chunk{Chunk: buf[:n]}})
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "read request body: %v", readErr)
+		}
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GatewayMarshaler is the default marshaler of the gateway, except that
+// server streams like StreamBooks are labelled as newline-delimited JSON.
+func GatewayMarshaler() grpc_run.Marshaler {
+	return ndjsonMarshaler{&grpc_run.HTTPBodyMarshaler{
+		Marshaler: &grpc_run.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}}
+}
+
+type ndjsonMarshaler struct {
+	grpc_run.Marshaler
+}
+
+func (ndjsonMarshaler) StreamContentType(any) string {
+	return "application/x-ndjson"
+}
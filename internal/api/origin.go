@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/textproto"
+	"strings"
+
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ParseTrustedProxies accepts both CIDRs ("10.0.0.0/8") and single addresses ("127.0.0.1").
+func ParseTrustedProxies(list []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(list))
+	for _, item := range list {
+		if strings.Contains(item, "/") {
+			prefix, err := netip.ParsePrefix(item)
+			if err != nil {
+				return nil, fmt.Errorf("netip.ParsePrefix: %w", err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(item)
+		if err != nil {
+			return nil, fmt.Errorf("netip.ParseAddr: %w", err)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// GatewayHeaderMatcher forwards the Forwarded and If-Match headers in addition
+// to what the gateway passes by default (User-Agent as grpcgateway-user-agent,
+// X-Forwarded-For).
+func GatewayHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case "Forwarded":
+		return "forwarded", true
+	case "If-Match":
+		return "if-match", true
+	}
+	return grpc_run.DefaultHeaderMatcher(key)
+}
+
+// GatewayOutgoingHeaderMatcher sends the etag response metadata as the ETag
+// header; other metadata keeps the default Grpc-Metadata- prefix.
+func GatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	return grpc_run.MetadataHeaderPrefix + key, true
+}
+
+// originFromCtx returns the address of the real client. Forwarding metadata is
+// only believed when the gRPC peer itself (normally our gateway) is a trusted
+// proxy; the chain is then walked from the right up to the first untrusted hop.
+func (s Server) originFromCtx(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("peer.FromContext: Error")
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "", fmt.Errorf("net.SplitHostPort: %w", err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !s.isTrustedProxy(addr) {
+		return host, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	hops := forwardedHops(md)
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			break
+		}
+		host = hop.Unmap().String()
+		if !s.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return host, nil
+}
+
+// userAgentFromCtx prefers the User-Agent of the HTTP client forwarded by
+// a trusted gateway over the one of the gRPC client.
+func (s Server) userAgentFromCtx(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, _ := net.SplitHostPort(p.Addr.String())
+		addr, err := netip.ParseAddr(host)
+		if err == nil && s.isTrustedProxy(addr) {
+			if ua := lastValue(md, grpc_run.MetadataPrefix+"user-agent"); ua != "" {
+				return ua
+			}
+		}
+	}
+	return lastValue(md, "user-agent")
+}
+
+func (s Server) isTrustedProxy(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range s.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedHops lists client addresses from left (original client) to right
+// (closest proxy). The gateway always appends the remote address of the HTTP
+// connection to X-Forwarded-For, so that entry ends the list even when the
+// proxy in front of it sent a Forwarded header instead.
+func forwardedHops(md metadata.MD) []string {
+	var xff []string
+	// Берём последнее значение: его добавил сам gateway, предыдущие мог прислать клиент
+	// через заголовок Grpc-Metadata-X-Forwarded-For.
+	for _, item := range strings.Split(lastValue(md, "x-forwarded-for"), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			xff = append(xff, item)
+		}
+	}
+
+	forwarded := parseForwardedFor(md.Get("forwarded"))
+	if len(forwarded) == 0 || len(xff) == 0 {
+		return xff
+	}
+	return append(forwarded, xff[len(xff)-1])
+}
+
+// parseForwardedFor extracts the for= parameters of RFC 7239 Forwarded headers.
+func parseForwardedFor(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			for _, pair := range strings.Split(element, ";") {
+				key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if !ok || !strings.EqualFold(key, "for") {
+					continue
+				}
+				val = strings.Trim(val, `"`)
+				if strings.HasPrefix(val, "[") {
+					// [2001:db8:cafe::17]:4711
+					if end := strings.Index(val, "]"); end > 0 {
+						val = val[1:end]
+					}
+				} else if host, _, err := net.SplitHostPort(val); err == nil {
+					val = host
+				}
+				hops = append(hops, val)
+			}
+		}
+	}
+	return hops
+}
+
+func lastValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
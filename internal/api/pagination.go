@@ -0,0 +1,138 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// pageToken is the opaque next_page_token: the sort key of the last book on
+// the page plus the request it belongs to, so it cannot be replayed with
+// another order or filter.
+type pageToken struct {
+	OrderBy string            `json:"o"`
+	Desc    bool              `json:"d,omitempty"`
+	Filter  domain.BookFilter `json:"f"`
+	ID      int               `json:"i"`
+	Title   string            `json:"t,omitempty"`
+	Year    int               `json:"y,omitempty"`
+}
+
+func encodePageToken(query domain.BookQuery, last domain.Book) string {
+	token := pageToken{
+		OrderBy: query.OrderBy,
+		Desc:    query.Desc,
+		Filter:  query.Filter,
+		ID:      last.ID,
+	}
+	switch query.OrderBy {
+	case "title":
+		token.Title = last.Title
+	case "year":
+		token.Year = last.Year
+	}
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+var errInvalidPageToken = errs.InvalidArgument("invalid page_token",
+	errs.FieldViolation{Field: "page_token", Description: "does not belong to this request"})
+
+func decodePageToken(raw string, query domain.BookQuery) (*domain.Book, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	again, _ := json.Marshal(pageToken{OrderBy: token.OrderBy, Desc: token.Desc, Filter: token.Filter})
+	want, _ := json.Marshal(pageToken{OrderBy: query.OrderBy, Desc: query.Desc, Filter: query.Filter})
+	if string(again) != string(want) {
+		return nil, errInvalidPageToken
+	}
+	return &domain.Book{ID: token.ID, Title: token.Title, Year: token.Year}, nil
+}
+
+// encodeOffsetToken is the next_page_token of lists ordered by ID alone, like
+// authors and revisions: the ID of the last item on the page.
+func encodeOffsetToken(lastID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(lastID)))
+}
+
+// decodeOffsetToken reads a token of encodeOffsetToken, an empty one is 0.
+func decodeOffsetToken(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return 0, errInvalidPageToken
+	}
+	afterID, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, errInvalidPageToken
+	}
+	return afterID, nil
+}
+
+func bookQueryFromRequest(request *pb.AllBooksRequests) (domain.BookQuery, error) {
+	query := domain.BookQuery{
+		Filter: bookFilter(request.TitleContains, request.YearMin, request.YearMax, request.OwnerId),
+		Limit:  int(request.PageSize),
+	}
+
+	query.OrderBy, query.Desc = bookOrder(request.OrderBy)
+
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+
+	if request.PageToken != "" {
+		after, err := decodePageToken(request.PageToken, query)
+		if err != nil {
+			return domain.BookQuery{}, err
+		}
+		query.After = after
+	}
+	return query, nil
+}
+
+func bookFilter(titleContains string, yearMin, yearMax *int64, ownerID int64) domain.BookFilter {
+	filter := domain.BookFilter{
+		TitleContains: titleContains,
+		OwnerID:       int(ownerID),
+	}
+	if yearMin != nil {
+		value := int(*yearMin)
+		filter.YearMin = &value
+	}
+	if yearMax != nil {
+		value := int(*yearMax)
+		filter.YearMax = &value
+	}
+	return filter
+}
+
+// bookOrder splits order_by like "year desc" into the field and the direction.
+func bookOrder(orderBy string) (string, bool) {
+	field, direction, _ := strings.Cut(orderBy, " ")
+	if field == "" {
+		field = "id"
+	}
+	return field, direction == "desc"
+}
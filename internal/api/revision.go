@@ -0,0 +1,89 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Server) ListBookRevisions(ctx context.Context, request *pb.ListBookRevisionsRequest) (*pb.ListBookRevisionsResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	afterID, err := decodeOffsetToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID := caller.UserID
+	if caller.IsAdmin {
+		ownerID = 0
+	}
+	revisions, err := s.Database.ListBookRevisions(ctx, int(request.BookId), ownerID, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if len(revisions) > pageSize {
+		revisions = revisions[:pageSize]
+		nextPageToken = encodeOffsetToken(revisions[len(revisions)-1].ID)
+	}
+
+	pbRevisions := make([]*pb.BookRevision, len(revisions))
+	for i := range revisions {
+		pbRevisions[i] = toBookRevision(revisions[i])
+	}
+	return &pb.ListBookRevisionsResponse{Revisions: pbRevisions, NextPageToken: nextPageToken}, nil
+}
+
+func (s Server) RevertBookToRevision(ctx context.Context, request *pb.RevertBookToRevisionRequest) (*pb.RevertBookToRevisionResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := expectedVersion(ctx, request.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	var book domain.Book
+	if caller.IsAdmin {
+		book, err = s.Database.RevertBookToRevision(ctx, int(request.BookId), int(request.RevisionId), version)
+	} else {
+		book, err = s.Database.RevertBookToRevisionOwnedBy(ctx, int(request.BookId), int(request.RevisionId), version, caller.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := setETagHeader(ctx, book); err != nil {
+		return nil, err
+	}
+	return &pb.RevertBookToRevisionResponse{Book: toBook(book)}, nil
+}
+
+func toBookRevision(u domain.BookRevision) *pb.BookRevision {
+	revision := &pb.BookRevision{
+		Id:        int64(u.ID),
+		BookId:    int64(u.BookID),
+		Version:   int64(u.Version),
+		Action:    u.Action,
+		ActorId:   int64(u.ActorID),
+		ChangedAt: timestamppb.New(u.ChangedAt),
+	}
+	if u.Before != nil {
+		revision.Before = toBook(*u.Before)
+	}
+	if u.After != nil {
+		revision.After = toBook(*u.After)
+	}
+	return revision
+}
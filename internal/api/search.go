@@ -0,0 +1,38 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"context"
+	"html"
+	"strings"
+)
+
+const defaultSearchPageSize = 20
+
+func (s Server) SearchBooks(ctx context.Context, request *pb.SearchBooksRequest) (*pb.SearchBooksResponse, error) {
+	limit := int(request.PageSize)
+	if limit <= 0 {
+		limit = defaultSearchPageSize
+	}
+
+	results, err := s.Database.SearchBooks(ctx, request.Query, limit)
+	if err != nil {
+		return nil, err
+	}
+	pbResults := make([]*pb.SearchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = &pb.SearchResult{
+			Book:    toBook(result.Book),
+			Rank:    result.Rank,
+			Snippet: snippetHTML(result.Snippet),
+		}
+	}
+	return &pb.SearchBooksResponse{Results: pbResults}, nil
+}
+
+// snippetHTML escapes the title of the snippet and only then marks the found
+// words with <b></b>, so markup in the title comes out as text.
+func snippetHTML(snippet string) string {
+	return strings.NewReplacer(domain.SnippetStart, "<b>", domain.SnippetStop, "</b>").Replace(html.EscapeString(snippet))
+}
@@ -3,63 +3,123 @@ package api
 import (
 	pb "bookserver_git/api/proto/v1"
 	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"bookserver_git/internal/isbn"
+	bookmeta "bookserver_git/internal/metadata"
+	"bookserver_git/internal/password"
 	"context"
-	"errors"
 	"fmt"
-	"net"
+	"net/netip"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/peer"
 )
 
 type Repository interface {
 	SaveBookToDatabase(book domain.Book, ctx context.Context) (domain.Book, error)
 	GetBookFromDatebase(id uint, ctx context.Context) (domain.Book, error)
-	DeleteBookFromDatebase(id uint, ctx context.Context) error
-	UpdateBookFromDatabase(book domain.Book, ctx context.Context) error
-	AllBooksFromDatabase(ctx context.Context) ([]domain.Book, error)
+	GetBookByIsbn(ctx context.Context, isbn string) (domain.Book, error)
+	DeleteBookFromDatebase(id uint, version int, ctx context.Context) error
+	UpdateBookFromDatabase(book domain.Book, fields []string, ctx context.Context) (domain.Book, error)
+	UpdateBookOwnedBy(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error)
+	DeleteBookOwnedBy(ctx context.Context, id uint, version int, userID int) error
+	AllBooksFromDatabase(ctx context.Context, query domain.BookQuery) ([]domain.Book, error)
+	CountBooks(ctx context.Context, filter domain.BookFilter) (int, error)
+	SearchBooks(ctx context.Context, text string, limit int) ([]domain.BookSearchResult, error)
+	ImportBooks(ctx context.Context, books []domain.Book, dryRun bool) ([]domain.ImportResult, error)
+	ExportBooks(ctx context.Context, filter domain.BookFilter, fn func(domain.Book) error) error
+	StreamBooks(ctx context.Context, query domain.BookQuery, fn func(domain.Book) error) error
+	UndeleteBook(ctx context.Context, id int) (domain.Book, error)
+	UndeleteBookOwnedBy(ctx context.Context, id int, userID int) (domain.Book, error)
+	PurgeDeletedBooks(ctx context.Context, retention time.Duration) (int64, error)
+	ListBookRevisions(ctx context.Context, bookID int, userID int, afterID int, limit int) ([]domain.BookRevision, error)
+	RevertBookToRevision(ctx context.Context, bookID int, revisionID int, version int) (domain.Book, error)
+	RevertBookToRevisionOwnedBy(ctx context.Context, bookID int, revisionID int, version int, userID int) (domain.Book, error)
+
+	SaveAuthor(ctx context.Context, author domain.Author) (domain.Author, error)
+	GetAuthor(ctx context.Context, id int) (domain.Author, error)
+	ListAuthors(ctx context.Context, nameContains string, afterID int, limit int) ([]domain.Author, error)
+	EnsureAuthors(ctx context.Context, names []string) ([]domain.Author, error)
+	MergeAuthors(ctx context.Context, targetID int, sourceIDs []int) (domain.Author, error)
 
 	SaveUserToDatabase(ctx context.Context, user domain.User) (domain.User, error)
 	GetUserByEmail(ctx context.Context, email string) (domain.User, error)
+	UpdateUserPassword(ctx context.Context, userID int, password string) error
 	SaveSessionToDatabase(ctx context.Context, session domain.Session) error
-	GetUserByToken(ctx context.Context, token string) (int, error)
+	GetUserByToken(ctx context.Context, token string) (domain.User, error)
+	ListUserSessions(ctx context.Context, userID int) ([]domain.Session, error)
+	DeleteSessionByToken(ctx context.Context, token string) error
+	DeleteUserSession(ctx context.Context, userID int, sessionID int) error
+	DeleteUserSessions(ctx context.Context, userID int, exceptToken string) (int64, error)
+	DeleteExpiredSessions(ctx context.Context) (int64, error)
+
+	// WithTx runs fn in one transaction, all calls to the Repository passed to
+	// fn are part of it. fn may be run again if the transaction fails on
+	// a concurrent one.
+	WithTx(ctx context.Context, fn func(Repository) error) error
 }
 
 type Server struct {
-	Database Repository
+	Database       Repository
+	Passwords      password.Hasher
+	TrustedProxies []netip.Prefix
+	// Metadata is nil when no providers are configured.
+	Metadata *bookmeta.Enricher
 }
 
 const authScheme = "Bearer"
 
 func (s Server) AddBook(ctx context.Context, request *pb.AddBookRequest) (*pb.AddBookResponse, error) {
-	token, err := auth.AuthFromMD(ctx, authScheme)
+	caller, err := principalFromCtx(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("AuthFromMD: %w", err)
+		return nil, err
 	}
 
-	userID, err := s.Database.GetUserByToken(ctx, token)
-
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	if request.Enrich {
+		request, err = s.enrichAddRequest(ctx, request, isbn13)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if request.Title == "" {
+		return nil, errs.InvalidArgument("invalid request",
+			errs.FieldViolation{Field: "title", Description: "value is required unless enrich finds it"})
+	}
 	newBook := domain.Book{
-		Title:  request.Title,
-		Year:   int(request.Year),
-		UserID: userID,
+		Title:     request.Title,
+		Year:      int(request.Year),
+		UserID:    caller.UserID,
+		ISBN:      isbn13,
+		Edition:   request.Edition,
+		Publisher: request.Publisher,
+		CoverURL:  request.CoverUrl,
 	}
-	result, err := s.Database.SaveBookToDatabase(newBook, ctx)
-	fmt.Println(result)
+	var result domain.Book
+	err = s.Database.WithTx(ctx, func(tx Repository) error {
+		var err error
+		newBook.Authors, err = resolveAuthors(ctx, tx, request.AuthorIds, request.AuthorNames)
+		if err != nil {
+			return err
+		}
+		result, err = tx.SaveBookToDatabase(newBook, ctx)
+		// log.Info("Добавили книгу в bd")
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	// log.Info("Добавили книгу в bd")
 
-	return &pb.AddBookResponse{Book: &pb.Book{
-		Id:     int64(result.ID),
-		Title:  result.Title,
-		Year:   int64(result.Year),
-		UserId: int64(result.UserID),
-	}}, nil
+	if err := setETagHeader(ctx, result); err != nil {
+		return nil, err
+	}
+	return &pb.AddBookResponse{Book: toBook(result)}, nil
 }
 
 func (s Server) GetBook(ctx context.Context, request *pb.GetBookRequest) (*pb.GetBookResponse, error) {
@@ -68,58 +128,176 @@ func (s Server) GetBook(ctx context.Context, request *pb.GetBookRequest) (*pb.Ge
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetBookResponse{Book: &pb.Book{
-		Id:     int64(book.ID),
-		Title:  book.Title,
-		Year:   int64(book.Year),
-		UserId: int64(book.UserID),
-	}}, nil
+	if err := setETagHeader(ctx, book); err != nil {
+		return nil, err
+	}
+	return &pb.GetBookResponse{Book: toBook(book)}, nil
+
+}
 
+func (s Server) GetBookByIsbn(ctx context.Context, request *pb.GetBookByIsbnRequest) (*pb.GetBookByIsbnResponse, error) {
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	book, err := s.Database.GetBookByIsbn(ctx, isbn13)
+	if err != nil {
+		return nil, err
+	}
+	if err := setETagHeader(ctx, book); err != nil {
+		return nil, err
+	}
+	return &pb.GetBookByIsbnResponse{Book: toBook(book)}, nil
 }
 
 func (s Server) DeleteBook(ctx context.Context, request *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, request.Etag)
+	if err != nil {
+		return nil, err
+	}
 
 	idint := request.Id
-	err := s.Database.DeleteBookFromDatebase(uint(idint), ctx)
+	if caller.IsAdmin {
+		err = s.Database.DeleteBookFromDatebase(uint(idint), version, ctx)
+	} else {
+		err = s.Database.DeleteBookOwnedBy(ctx, uint(idint), version, caller.UserID)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &pb.DeleteBookResponse{}, nil
 
 }
 
 func (s Server) UpdateBook(ctx context.Context, request *pb.UpdateBookRequest) (*pb.UpdateBookResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := bookUpdateFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	fromBookPatch(request)
+	if update.has("title") && request.Title == "" {
+		return nil, errs.InvalidArgument("invalid request",
+			errs.FieldViolation{Field: "title", Description: "value is required"})
+	}
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	version, err := expectedVersion(ctx, request.Etag)
+	if err != nil {
+		return nil, err
+	}
+
 	newBook := domain.Book{
-		ID:    int(request.Id),
-		Title: request.Title,
-		Year:  int(request.Year),
+		ID:        int(request.Id),
+		Title:     request.Title,
+		Year:      int(request.Year),
+		ISBN:      isbn13,
+		Edition:   request.Edition,
+		Publisher: request.Publisher,
+		CoverURL:  request.CoverUrl,
+		Version:   version,
+	}
+
+	// Поля и авторы пишутся одной записью: одна версия и одна ревизия.
+	fields := update.fields
+	if update.authors {
+		fields = append(slices.Clone(fields), domain.BookAuthorsField)
 	}
 
-	err := s.Database.UpdateBookFromDatabase(newBook, ctx)
+	var result domain.Book
+	err = s.Database.WithTx(ctx, func(tx Repository) error {
+		var err error
+		if update.authors {
+			newBook.Authors, err = resolveAuthors(ctx, tx, request.AuthorIds, request.AuthorNames)
+			if err != nil {
+				return err
+			}
+		}
+		if caller.IsAdmin {
+			result, err = tx.UpdateBookFromDatabase(newBook, fields, ctx)
+		} else {
+			result, err = tx.UpdateBookOwnedBy(ctx, newBook, fields, caller.UserID)
+		}
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	if err := setETagHeader(ctx, result); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateBookResponse{Book: toBook(result)}, nil
 }
 
 func (s Server) AllBooks(ctx context.Context, request *pb.AllBooksRequests) (*pb.AllBooksResponse, error) {
+	query, err := bookQueryFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := query.Limit
+	// Одна лишняя книга показывает, есть ли следующая страница.
+	query.Limit++
 
-	books, err := s.Database.AllBooksFromDatabase(ctx)
+	books, err := s.Database.AllBooksFromDatabase(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.Database.CountBooks(ctx, query.Filter)
 	if err != nil {
 		return nil, err
 	}
+
+	nextPageToken := ""
+	if len(books) > pageSize {
+		books = books[:pageSize]
+		nextPageToken = encodePageToken(query, books[len(books)-1])
+	}
+
 	pbBooks := make([]*pb.Book, len(books))
 	for i := range books {
 		pbBooks[i] = toBook(books[i])
 	}
 
-	return &pb.AllBooksResponse{Books: pbBooks}, nil
+	return &pb.AllBooksResponse{
+		Books:         pbBooks,
+		NextPageToken: nextPageToken,
+		TotalSize:     int64(total),
+	}, nil
+}
+
+func (s Server) StreamBooks(request *pb.StreamBooksRequest, stream pb.BookAPI_StreamBooksServer) error {
+	query := domain.BookQuery{
+		Filter: bookFilter(request.TitleContains, request.YearMin, request.YearMax, request.OwnerId),
+		Limit:  int(request.Limit),
+	}
+	query.OrderBy, query.Desc = bookOrder(request.OrderBy)
+
+	// Send ждёт, пока клиент освободит окно, так что медленный клиент
+	// тормозит чтение строк, а не копит книги в памяти.
+	return s.Database.StreamBooks(stream.Context(), query, func(book domain.Book) error {
+		return stream.Send(toBook(book))
+	})
 }
 
 func (s Server) Registration(ctx context.Context, request *pb.RegistrationRequest) (*pb.RegistrationResponse, error) {
+	hash, err := s.Passwords.Hash(request.Password)
+	if err != nil {
+		return nil, fmt.Errorf("Passwords.Hash: %w", err)
+	}
 	newUser := domain.User{
 		Email:    request.Email,
-		Password: request.Password,
+		Password: hash,
 	}
 	registeredUser, err := s.Database.SaveUserToDatabase(ctx, newUser)
 	if err != nil {
@@ -130,19 +308,35 @@ func (s Server) Registration(ctx context.Context, request *pb.RegistrationReques
 }
 
 var (
-	ErrInvalidPassword = errors.New("invalid password")
+	ErrInvalidPassword = errs.Unauthenticated("invalid email or password")
 )
 
 func (s Server) Login(ctx context.Context, request *pb.LoginRequest) (*pb.LoginResponse, error) {
 	user, err := s.Database.GetUserByEmail(ctx, request.Email)
+	if errs.Is(err, errs.KindNotFound) {
+		// Не подсказываем, зарегистрирован ли такой email.
+		return nil, ErrInvalidPassword
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if user.Password != request.Password {
+	ok, rehash, err := s.Passwords.Verify(request.Password, user.Password)
+	if err != nil {
+		return nil, fmt.Errorf("Passwords.Verify: %w", err)
+	}
+	if !ok {
 		return nil, ErrInvalidPassword
 	}
-	ip, err := originFromCtx(ctx)
+	// Старый хеш (или пароль в открытом виде) заменяем на хеш с текущими параметрами.
+	var hash string
+	if rehash {
+		hash, err = s.Passwords.Hash(request.Password)
+		if err != nil {
+			return nil, fmt.Errorf("Passwords.Hash: %w", err)
+		}
+	}
+	ip, err := s.originFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -153,9 +347,16 @@ func (s Server) Login(ctx context.Context, request *pb.LoginRequest) (*pb.LoginR
 		UserID:    user.ID,
 		Token:     token,
 		IP:        ip,
-		UserAgent: "", // TODO
+		UserAgent: s.userAgentFromCtx(ctx),
 	}
-	err = s.Database.SaveSessionToDatabase(ctx, session)
+	err = s.Database.WithTx(ctx, func(tx Repository) error {
+		if rehash {
+			if err := tx.UpdateUserPassword(ctx, user.ID, hash); err != nil {
+				return err
+			}
+		}
+		return tx.SaveSessionToDatabase(ctx, session)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -168,24 +369,37 @@ func (s Server) Login(ctx context.Context, request *pb.LoginRequest) (*pb.LoginR
 	}}, nil
 }
 
-func originFromCtx(ctx context.Context) (string, error) {
-	p, ok := peer.FromContext(ctx)
-	if !ok {
-		return "", fmt.Errorf("peer.FromContext: Error")
+func toBook(u domain.Book) *pb.Book {
+	authors := make([]*pb.Author, len(u.Authors))
+	for i := range u.Authors {
+		authors[i] = toAuthor(u.Authors[i])
 	}
-	host, _, err := net.SplitHostPort(p.Addr.String())
-	if err != nil {
-		return "", fmt.Errorf("net.SplitHostPort: %w", err)
+	return &pb.Book{
+		Id:        int64(u.ID),
+		Title:     u.Title,
+		Year:      int64(u.Year),
+		UserId:    int64(u.UserID),
+		Authors:   authors,
+		Isbn:      u.ISBN,
+		Edition:   u.Edition,
+		Publisher: u.Publisher,
+		CoverUrl:  u.CoverURL,
+		Etag:      bookETag(u.Version),
+		DeletedAt: deletedAt(u.DeletedAt),
 	}
-	return host, nil
 }
 
-func toBook(u domain.Book) *pb.Book {
-	return &pb.Book{
-		Id:    int64(u.ID),
-		Title: u.Title,
-		Year:  int64(u.Year),
+// normalizeISBN checks the checksum and converts ISBN-10 to ISBN-13.
+// An empty string means the book has no ISBN.
+func normalizeISBN(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	normalized, err := isbn.Normalize(raw)
+	if err != nil {
+		return "", errs.InvalidArgument("invalid isbn", errs.FieldViolation{Field: "isbn", Description: err.Error()})
 	}
+	return normalized, nil
 }
 
 // func (s Server) AddBook(w http.ResponseWriter, r *http.Request) {
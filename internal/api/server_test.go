@@ -0,0 +1,76 @@
+package api_test
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/principal"
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var admin = principal.Principal{UserID: 1, IsAdmin: true}
+
+// serve starts server in memory with the interceptors of cmd/libs, except
+// that instead of authenticating every call is made by an admin.
+func serve(t *testing.T, server api.Server) *grpc.ClientConn {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			api.UnaryErrorInterceptor(log),
+			func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				return handler(principal.NewContext(ctx, admin), req)
+			},
+			api.UnaryValidateInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			api.StreamErrorInterceptor(log),
+			func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				wrapped := middleware.WrapServerStream(ss)
+				wrapped.WrappedContext = principal.NewContext(ss.Context(), admin)
+				return handler(srv, wrapped)
+			},
+			api.StreamValidateInterceptor(),
+		),
+	)
+	pb.RegisterBookAPIServer(grpcServer, server)
+	listener := bufconn.Listen(1 << 20)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// gateway returns the HTTP gateway of cmd/libs in front of conn.
+func gateway(t *testing.T, conn *grpc.ClientConn) *grpc_run.ServeMux {
+	t.Helper()
+	gw := grpc_run.NewServeMux(
+		grpc_run.WithIncomingHeaderMatcher(api.GatewayHeaderMatcher),
+		grpc_run.WithOutgoingHeaderMatcher(api.GatewayOutgoingHeaderMatcher),
+		grpc_run.WithErrorHandler(api.GatewayErrorHandler),
+		grpc_run.WithMarshalerOption(grpc_run.MIMEWildcard, api.GatewayMarshaler()),
+	)
+	if err := pb.RegisterBookAPIHandler(context.Background(), gw, conn); err != nil {
+		t.Fatal(err)
+	}
+	return gw
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Server) Logout(ctx context.Context, request *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.DeleteSessionByToken(ctx, caller.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{}, nil
+}
+
+func (s Server) ListSessions(ctx context.Context, request *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.Database.ListUserSessions(ctx, caller.UserID)
+	if err != nil {
+		return nil, err
+	}
+	pbSessions := make([]*pb.Session, len(sessions))
+	for i := range sessions {
+		pbSessions[i] = toSession(sessions[i], caller.Token)
+	}
+	return &pb.ListSessionsResponse{Sessions: pbSessions}, nil
+}
+
+func (s Server) RevokeSession(ctx context.Context, request *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.DeleteUserSession(ctx, caller.UserID, int(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func (s Server) RevokeAllSessions(ctx context.Context, request *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptToken := ""
+	if request.KeepCurrent {
+		exceptToken = caller.Token
+	}
+	revoked, err := s.Database.DeleteUserSessions(ctx, caller.UserID, exceptToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAllSessionsResponse{Revoked: revoked}, nil
+}
+
+// toSession never copies the token itself, it only marks the caller's own session.
+func toSession(u domain.Session, currentToken string) *pb.Session {
+	return &pb.Session{
+		Id:         int64(u.ID),
+		Ip:         u.IP,
+		UserAgent:  u.UserAgent,
+		CreatedAt:  timestamppb.New(u.CreatedAt),
+		LastSeenAt: timestamppb.New(u.LastSeenAt),
+		Current:    u.Token == currentToken,
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Server) ListDeletedBooks(ctx context.Context, request *pb.ListDeletedBooksRequest) (*pb.ListDeletedBooksResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := domain.BookQuery{
+		Filter:  domain.BookFilter{Deleted: true},
+		OrderBy: "id",
+		Limit:   int(request.PageSize),
+	}
+	if !caller.IsAdmin {
+		query.Filter.OwnerID = caller.UserID
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if request.PageToken != "" {
+		// Фильтр в токене не даёт листать корзину токеном от AllBooks.
+		query.After, err = decodePageToken(request.PageToken, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pageSize := query.Limit
+	query.Limit++
+
+	books, err := s.Database.AllBooksFromDatabase(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken := ""
+	if len(books) > pageSize {
+		books = books[:pageSize]
+		nextPageToken = encodePageToken(query, books[len(books)-1])
+	}
+
+	pbBooks := make([]*pb.Book, len(books))
+	for i := range books {
+		pbBooks[i] = toBook(books[i])
+	}
+	return &pb.ListDeletedBooksResponse{Books: pbBooks, NextPageToken: nextPageToken}, nil
+}
+
+func (s Server) UndeleteBook(ctx context.Context, request *pb.UndeleteBookRequest) (*pb.UndeleteBookResponse, error) {
+	caller, err := principalFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var book domain.Book
+	if caller.IsAdmin {
+		book, err = s.Database.UndeleteBook(ctx, int(request.Id))
+	} else {
+		book, err = s.Database.UndeleteBookOwnedBy(ctx, int(request.Id), caller.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := setETagHeader(ctx, book); err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteBookResponse{Book: toBook(book)}, nil
+}
+
+func deletedAt(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
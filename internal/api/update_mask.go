@@ -0,0 +1,73 @@
+package api
+
+import (
+	pb "bookserver_git/api/proto/v1"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"slices"
+)
+
+// bookUpdate says what UpdateBook changes: the columns of domain.BookFields
+// and whether the authors are replaced.
+type bookUpdate struct {
+	fields  []string
+	authors bool
+}
+
+func (u bookUpdate) has(field string) bool {
+	return slices.Contains(u.fields, field)
+}
+
+// bookUpdateFromRequest reads update_mask. Without it every field is replaced
+// and the authors only if some were sent, as before the mask existed. A PATCH
+// (book is set) always has a mask, built by the gateway from its body.
+func bookUpdateFromRequest(request *pb.UpdateBookRequest) (bookUpdate, error) {
+	paths := request.GetUpdateMask().GetPaths()
+	if request.Book != nil {
+		// Для пустого тела gateway присылает маску из одного пустого пути.
+		paths = slices.DeleteFunc(slices.Clone(paths), func(path string) bool { return path == "" })
+	}
+	if request.Book != nil && len(paths) == 0 {
+		return bookUpdate{}, errs.InvalidArgument("invalid request",
+			errs.FieldViolation{Field: "book", Description: "no fields to update"})
+	}
+	if len(paths) == 0 {
+		return bookUpdate{
+			fields:  domain.BookFields,
+			authors: len(request.AuthorIds) > 0 || len(request.AuthorNames) > 0,
+		}, nil
+	}
+
+	var update bookUpdate
+	for _, path := range paths {
+		switch {
+		case path == "authors" || path == "author_ids" || path == "author_names":
+			update.authors = true
+		case slices.Contains(domain.BookFields, path):
+			if !update.has(path) {
+				update.fields = append(update.fields, path)
+			}
+		default:
+			return bookUpdate{}, errs.InvalidArgument("invalid update_mask",
+				errs.FieldViolation{Field: "update_mask", Description: "field " + path + " cannot be updated"})
+		}
+	}
+	return update, nil
+}
+
+// fromBookPatch moves the fields of the PATCH body to the request, where
+// UpdateBook reads them.
+func fromBookPatch(request *pb.UpdateBookRequest) {
+	patch := request.Book
+	if patch == nil {
+		return
+	}
+	request.Title = patch.Title
+	request.Year = patch.Year
+	request.AuthorIds = patch.AuthorIds
+	request.AuthorNames = patch.AuthorNames
+	request.Isbn = patch.Isbn
+	request.Edition = patch.Edition
+	request.Publisher = patch.Publisher
+	request.CoverUrl = patch.CoverUrl
+}
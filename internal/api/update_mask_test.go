@@ -0,0 +1,66 @@
+package api_test
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/memory"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// patchBook sends body as PATCH /book/{id} through the gateway and the gRPC
+// server, and returns the response with the book as stored afterwards.
+func patchBook(t *testing.T, body string) (*httptest.ResponseRecorder, domain.Book) {
+	t.Helper()
+	ctx := context.Background()
+	repo := memory.NewRepository(domain.SessionTTL{})
+	book, err := repo.SaveBookToDatabase(domain.Book{
+		Title:     "Dune",
+		Year:      1965,
+		ISBN:      "9780441013593",
+		Publisher: "Chilton",
+	}, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gw := gateway(t, serve(t, api.Server{Database: repo}))
+
+	request := httptest.NewRequest(http.MethodPatch, "/book/"+strconv.Itoa(book.ID), strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	response := httptest.NewRecorder()
+	gw.ServeHTTP(response, request)
+
+	got, err := repo.GetBookFromDatebase(uint(book.ID), ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response, got
+}
+
+func TestPatchBookOneField(t *testing.T) {
+	response, got := patchBook(t, `{"year": 1966}`)
+	if response.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", response.Code, response.Body)
+	}
+	if got.Year != 1966 {
+		t.Errorf("year %d, want 1966", got.Year)
+	}
+	if got.Title != "Dune" || got.ISBN != "9780441013593" || got.Publisher != "Chilton" {
+		t.Errorf("fields not sent were changed: %+v", got)
+	}
+}
+
+func TestPatchBookNoFields(t *testing.T) {
+	response, got := patchBook(t, `{}`)
+	if response.Code != http.StatusBadRequest {
+		t.Fatalf("status %d, want 400: %s", response.Code, response.Body)
+	}
+	if got.Title != "Dune" || got.Year != 1965 {
+		t.Errorf("book changed: %+v", got)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bookserver_git/internal/errs"
+	"context"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc"
+)
+
+// validatorAll is implemented by every message generated by protoc-gen-validate.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// UnaryValidateInterceptor rejects requests that break the (validate.rules)
+// of the proto with InvalidArgument listing every field violation.
+func UnaryValidateInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validateMessage(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidateInterceptor validates every message received from the client.
+func StreamValidateInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(m)
+}
+
+func validateMessage(m any) error {
+	v, ok := m.(validatorAll)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+	return errs.InvalidArgument("invalid request", fieldViolations("", err)...)
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// fieldViolations flattens the errors of ValidateAll, nested messages
+// become dotted paths like "book.title".
+func fieldViolations(prefix string, err error) []errs.FieldViolation {
+	if multi, ok := err.(multiError); ok {
+		var result []errs.FieldViolation
+		for _, e := range multi.AllErrors() {
+			result = append(result, fieldViolations(prefix, e)...)
+		}
+		return result
+	}
+
+	fe, ok := err.(fieldError)
+	if !ok {
+		return []errs.FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+	field := snakeCase(fe.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	switch fe.Cause().(type) {
+	case multiError, fieldError:
+		return fieldViolations(field, fe.Cause())
+	}
+	return []errs.FieldViolation{{Field: field, Description: fe.Reason()}}
+}
+
+// snakeCase turns the Go field names used by protoc-gen-validate ("UserId")
+// back into proto names ("user_id").
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,285 @@
+// Package config loads the service configuration: defaults, then the YAML
+// file, then environment variables.
+//
+// Every key can be overridden by BOOKSERVER_ and its path in upper case,
+// joined with "_": BOOKSERVER_DSN, BOOKSERVER_SESSION_IDLE_TTL,
+// BOOKSERVER_METADATA_PROVIDERS_0_API_KEY. The value is parsed as YAML, so
+// lists are written as [a, b]. With the _FILE suffix the value is read from
+// the named file instead, for secrets mounted as files.
+package config
+
+import (
+	"bookserver_git/internal/metadata"
+	"bookserver_git/internal/password"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	// Driver выбирает хранилище: postgres, sqlite — файл по пути из DSN,
+	// или memory — всё в памяти процесса, для тестов и демо.
+	Driver string `yaml:"driver"`
+	DSN    string `yaml:"dsn"`
+	// Папка с миграциями драйвера, по умолчанию migrations или
+	// migrations_sqlite. Относительный путь считается от папки конфига.
+	MigrationsDir string `yaml:"migrations_dir"`
+	LogLevel      int    `yaml:"log_level"`
+	Host          string `yaml:"host"`
+	HostGRPC      string `yaml:"host_grpc"`
+	// Сколько ждать завершения текущих запросов после SIGTERM.
+	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout"`
+	Password        password.Config `yaml:"password"`
+	Session         SessionConfig   `yaml:"session"`
+	Trash           TrashConfig     `yaml:"trash"`
+	Health          HealthConfig    `yaml:"health"`
+	// Адреса и подсети прокси (включая сам gateway), которым можно верить
+	// в X-Forwarded-For и Forwarded.
+	TrustedProxies []string        `yaml:"trusted_proxies"`
+	Metadata       metadata.Config `yaml:"metadata"`
+}
+
+type SessionConfig struct {
+	AbsoluteTTL   time.Duration `yaml:"absolute_ttl"`
+	IdleTTL       time.Duration `yaml:"idle_ttl"`
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+}
+
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+// HealthConfig задаёт проверку готовности: базы и её миграций.
+type HealthConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+	CheckTimeout  time.Duration `yaml:"check_timeout"`
+}
+
+// Drivers are the values of Config.Driver.
+var Drivers = []string{"postgres", "sqlite", "memory"}
+
+// defaultMigrationsDirs are the migrations_dir of the drivers that have a schema.
+var defaultMigrationsDirs = map[string]string{
+	"postgres": "migrations",
+	"sqlite":   "migrations_sqlite",
+}
+
+// EnvPrefix starts the names of the environment variables that override keys.
+const EnvPrefix = "BOOKSERVER_"
+
+func defaults() Config {
+	return Config{
+		Driver:          "postgres",
+		Host:            "0.0.0.0:8080",
+		HostGRPC:        "127.0.0.1:8081",
+		ShutdownTimeout: 15 * time.Second,
+		Password:        password.Config{Algorithm: password.AlgorithmArgon2id},
+		Session: SessionConfig{
+			AbsoluteTTL:   720 * time.Hour,
+			IdleTTL:       72 * time.Hour,
+			SweepInterval: 10 * time.Minute,
+		},
+		Trash: TrashConfig{
+			Retention:     720 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+		Health: HealthConfig{
+			CheckInterval: 10 * time.Second,
+			CheckTimeout:  3 * time.Second,
+		},
+	}
+}
+
+// Load reads the config from the YAML file at path and the environment.
+// Unknown keys, in the file or as BOOKSERVER_ variables, are errors, so are
+// invalid values; all problems are reported at once.
+func Load(path string) (Config, error) {
+	cfg := defaults()
+	if err := readFile(path, &cfg); err != nil {
+		return Config{}, fmt.Errorf("config %s: %w", path, err)
+	}
+	if err := applyEnv(&cfg, os.Environ()); err != nil {
+		return Config{}, fmt.Errorf("config from environment: %w", err)
+	}
+	if err := cfg.resolveMigrationsDir(path); err != nil {
+		return Config{}, fmt.Errorf("config %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+func readFile(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// resolveMigrationsDir makes migrations_dir an absolute path, so the server
+// does not depend on the directory it is started from.
+func (c *Config) resolveMigrationsDir(configPath string) error {
+	if c.MigrationsDir == "" {
+		c.MigrationsDir = defaultMigrationsDirs[c.Driver]
+	}
+	if c.MigrationsDir == "" {
+		return nil
+	}
+	dir := c.MigrationsDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(filepath.Dir(configPath), dir)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("migrations_dir: %w", err)
+	}
+	c.MigrationsDir = dir
+	return nil
+}
+
+// applyEnv sets the keys given in environ, a list of "NAME=value".
+func applyEnv(cfg *Config, environ []string) error {
+	env := make(map[string]string)
+	for _, kv := range environ {
+		name, value, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, EnvPrefix) {
+			env[name] = value
+		}
+	}
+
+	var errs []error
+	walkKeys(reflect.ValueOf(cfg).Elem(), strings.TrimSuffix(EnvPrefix, "_"), func(name string, field reflect.Value) {
+		value, ok := env[name]
+		fileName, fromFile := env[name+"_FILE"]
+		delete(env, name)
+		delete(env, name+"_FILE")
+		switch {
+		case ok && fromFile:
+			errs = append(errs, fmt.Errorf("%s and %s_FILE are both set", name, name))
+			return
+		case fromFile:
+			data, err := os.ReadFile(fileName)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s_FILE: %w", name, err))
+				return
+			}
+			value = strings.TrimRight(string(data), "\r\n")
+		case !ok:
+			return
+		}
+		if err := setValue(field, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	})
+
+	unknown := make([]string, 0, len(env))
+	for name := range env {
+		unknown = append(unknown, name)
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		errs = append(errs, fmt.Errorf("%s: unknown variable", name))
+	}
+	return errors.Join(errs...)
+}
+
+// walkKeys calls fn for every key under v with its variable name. Elements
+// of lists of sections are numbered from 0, only those already in the file
+// are visited.
+func walkKeys(v reflect.Value, name string, fn func(name string, field reflect.Value)) {
+	switch {
+	case v.Kind() == reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			tag, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("yaml"), ",")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			walkKeys(v.Field(i), name+"_"+strings.ToUpper(tag), fn)
+		}
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Struct:
+		for i := 0; i < v.Len(); i++ {
+			walkKeys(v.Index(i), name+"_"+strconv.Itoa(i), fn)
+		}
+	default:
+		fn(name, v)
+	}
+}
+
+// setValue parses the value as YAML into the field. Strings are taken as is,
+// so a DSN does not need YAML quoting.
+func setValue(field reflect.Value, value string) error {
+	if field.Kind() == reflect.String {
+		field.SetString(value)
+		return nil
+	}
+	return yaml.Unmarshal([]byte(value), field.Addr().Interface())
+}
+
+func (c Config) validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: "+format, append([]any{key}, args...)...))
+		}
+	}
+
+	check(slices.Contains(Drivers, c.Driver), "driver", "must be one of %s, got %q", strings.Join(Drivers, ", "), c.Driver)
+	check(c.Driver == "memory" || c.DSN != "", "dsn", "is required for driver %s", c.Driver)
+	if _, ok := defaultMigrationsDirs[c.Driver]; ok {
+		info, err := os.Stat(c.MigrationsDir)
+		check(err == nil && info.IsDir(), "migrations_dir", "must be a directory, got %q", c.MigrationsDir)
+	}
+	for key, addr := range map[string]string{"host": c.Host, "host_grpc": c.HostGRPC} {
+		_, _, err := net.SplitHostPort(addr)
+		check(err == nil, key, "must be host:port, got %q", addr)
+	}
+	// Без времени на завершение запросы обрывались бы, а остановка считалась бы ошибкой.
+	check(c.ShutdownTimeout > 0, "shutdown_timeout", "must be positive, got %s", c.ShutdownTimeout)
+	check(c.Password.Algorithm == password.AlgorithmArgon2id || c.Password.Algorithm == password.AlgorithmBcrypt,
+		"password.algorithm", "must be %s or %s, got %q", password.AlgorithmArgon2id, password.AlgorithmBcrypt, c.Password.Algorithm)
+
+	// 0 у TTL сессий, trash.retention и check_timeout — без ограничения
+	// (корзина тогда не чистится), у metadata.cache_ttl — кэш выключен,
+	// у интервала — воркер выключен.
+	for key, d := range map[string]time.Duration{
+		"session.absolute_ttl":   c.Session.AbsoluteTTL,
+		"session.idle_ttl":       c.Session.IdleTTL,
+		"session.sweep_interval": c.Session.SweepInterval,
+		"trash.retention":        c.Trash.Retention,
+		"trash.purge_interval":   c.Trash.PurgeInterval,
+		"metadata.cache_ttl":     c.Metadata.CacheTTL,
+		"health.check_interval":  c.Health.CheckInterval,
+		"health.check_timeout":   c.Health.CheckTimeout,
+	} {
+		check(d >= 0, key, "must not be negative, got %s", d)
+	}
+	for i, p := range c.Metadata.Providers {
+		key := fmt.Sprintf("metadata.providers[%d]", i)
+		check(p.Name != "", key+".name", "is required")
+		check(p.Timeout >= 0, key+".timeout", "must not be negative, got %s", p.Timeout)
+	}
+
+	// Порядок ошибок не должен зависеть от обхода map.
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	return errors.Join(errs...)
+}
@@ -0,0 +1,198 @@
+package db
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+func (d Repository) SaveAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	query := "INSERT INTO authors (name) VALUES($1) RETURNING id, name"
+	err := d.db.QueryRowContext(ctx, query, author.Name).Scan(&author.ID, &author.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", author.Name)
+	}
+	return author, nil
+}
+
+func (d Repository) GetAuthor(ctx context.Context, id int) (domain.Author, error) {
+	var author domain.Author
+	query := "SELECT id, name FROM authors WHERE id = $1"
+	err := d.db.QueryRowContext(ctx, query, id).Scan(&author.ID, &author.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", id)
+	}
+	return author, nil
+}
+
+// ListAuthors returns authors ordered by ID, starting after afterID.
+func (d Repository) ListAuthors(ctx context.Context, nameContains string, afterID int, limit int) ([]domain.Author, error) {
+	query := `SELECT id, name FROM authors
+		WHERE id > $1 AND ($2 = '' OR name ILIKE '%' || $2 || '%')
+		ORDER BY id
+		LIMIT $3`
+	rows, err := d.db.QueryContext(ctx, query, afterID, likeEscaper.Replace(nameContains), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []domain.Author
+	for rows.Next() {
+		var author domain.Author
+		if err := rows.Scan(&author.ID, &author.Name); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+	return authors, rows.Err()
+}
+
+// ensureAuthorQuery returns the author with the name, creating it if needed.
+const ensureAuthorQuery = `INSERT INTO authors (name) VALUES($1)
+	ON CONFLICT ((lower(name))) DO UPDATE SET name = authors.name
+	RETURNING id, name`
+
+// EnsureAuthors returns the authors with the given names, creating the missing
+// ones. Names are matched case-insensitively.
+func (d Repository) EnsureAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	authors := make([]domain.Author, len(names))
+	for i, name := range names {
+		err := d.db.QueryRowContext(ctx, ensureAuthorQuery, name).Scan(&authors[i].ID, &authors[i].Name)
+		if err != nil {
+			return nil, dbError(err, "author", name)
+		}
+	}
+	return authors, nil
+}
+
+// setBookAuthors replaces the authors of the book keeping the given order.
+// Bumping the version and recording the revision is up to the caller, as part
+// of the write the authors belong to.
+func setBookAuthors(ctx context.Context, tx *sql.Tx, bookID int, authors []domain.Author) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM book_authors WHERE book_id = $1", bookID)
+	if err != nil {
+		return err
+	}
+	query := "INSERT INTO book_authors (book_id, author_id, position) VALUES($1,$2,$3) ON CONFLICT DO NOTHING"
+	for position, author := range authors {
+		_, err = tx.ExecContext(ctx, query, bookID, author.ID, position)
+		if err != nil {
+			return dbError(err, "author", author.ID)
+		}
+	}
+	return nil
+}
+
+// MergeAuthors moves the books of sourceIDs to targetID and deletes the sources.
+func (d Repository) MergeAuthors(ctx context.Context, targetID int, sourceIDs []int) (domain.Author, error) {
+	var target domain.Author
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		var err error
+		target, err = mergeAuthors(ctx, tx, targetID, sourceIDs)
+		return err
+	})
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return target, nil
+}
+
+func mergeAuthors(ctx context.Context, tx *sql.Tx, targetID int, sourceIDs []int) (domain.Author, error) {
+	var target domain.Author
+	err := tx.QueryRowContext(ctx, "SELECT id, name FROM authors WHERE id = $1 FOR UPDATE", targetID).Scan(&target.ID, &target.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", targetID)
+	}
+
+	query := "SELECT count(*) FROM authors WHERE id = ANY($1)"
+	var found int
+	err = tx.QueryRowContext(ctx, query, pq.Array(sourceIDs)).Scan(&found)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	if found != len(sourceIDs) {
+		return domain.Author{}, errs.NotFound("author", fmt.Sprint(sourceIDs))
+	}
+
+	// Список авторов у этих книг меняется, значит и версия.
+	var bookIDs []int64
+	query = "SELECT array_agg(DISTINCT book_id) FROM book_authors WHERE author_id = ANY($1)"
+	err = tx.QueryRowContext(ctx, query, pq.Array(sourceIDs)).Scan(pq.Array(&bookIDs))
+	if err != nil {
+		return domain.Author{}, err
+	}
+	befores := make([]domain.Book, len(bookIDs))
+	for i, id := range bookIDs {
+		if befores[i], err = lockBook(ctx, tx, int(id)); err != nil {
+			return domain.Author{}, err
+		}
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE books SET version = version + 1 WHERE id = ANY($1)", pq.Array(bookIDs))
+	if err != nil {
+		return domain.Author{}, err
+	}
+
+	// Книга, у которой уже есть target, остаётся со своей позицией.
+	query = `INSERT INTO book_authors (book_id, author_id, position)
+		SELECT book_id, $1, min(position) FROM book_authors
+		WHERE author_id = ANY($2)
+		GROUP BY book_id
+		ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, targetID, pq.Array(sourceIDs))
+	if err != nil {
+		return domain.Author{}, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM authors WHERE id = ANY($1)", pq.Array(sourceIDs))
+	if err != nil {
+		return domain.Author{}, err
+	}
+	for _, before := range befores {
+		after, err := lockBook(ctx, tx, before.ID)
+		if err != nil {
+			return domain.Author{}, err
+		}
+		if err := recordRevision(ctx, tx, domain.RevisionUpdate, &before, &after); err != nil {
+			return domain.Author{}, err
+		}
+	}
+	return target, nil
+}
+
+// loadAuthors fills Authors of every book with a single query.
+func (d Repository) loadAuthors(ctx context.Context, books []domain.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(books))
+	index := make(map[int][]int, len(books))
+	for i, book := range books {
+		ids[i] = int64(book.ID)
+		index[book.ID] = append(index[book.ID], i)
+	}
+
+	query := `SELECT ba.book_id, a.id, a.name
+		FROM book_authors ba JOIN authors a ON a.id = ba.author_id
+		WHERE ba.book_id = ANY($1)
+		ORDER BY ba.book_id, ba.position, a.id`
+	rows, err := d.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bookID int
+		var author domain.Author
+		if err := rows.Scan(&bookID, &author.ID, &author.Name); err != nil {
+			return err
+		}
+		for _, i := range index[bookID] {
+			books[i].Authors = append(books[i].Authors, author)
+		}
+	}
+	return rows.Err()
+}
@@ -2,107 +2,546 @@ package db
 
 import (
 	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lib/pq"
 )
 
 type Repository struct {
-	db *sql.DB
+	conn *sql.DB
+	// db is conn or, inside WithTx, tx.
+	db         querier
+	tx         *sql.Tx
+	sessionTTL domain.SessionTTL
+}
+
+func NewRepository(rawDB *sql.DB, sessionTTL domain.SessionTTL) *Repository {
+	return &Repository{conn: rawDB, db: rawDB, sessionTTL: sessionTTL}
+}
+
+// bookColumns is the column list read by scanBook.
+const bookColumns = "id, title, year_book, user_id, COALESCE(isbn, ''), edition, publisher, cover_url, version, deleted_at"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanBook reads bookColumns followed by the extra columns, if any.
+func scanBook(row scanner, book *domain.Book, extra ...any) error {
+	dest := []any{&book.ID, &book.Title, &book.Year, &book.UserID, &book.ISBN, &book.Edition, &book.Publisher, &book.CoverURL, &book.Version, &book.DeletedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// bookAuthorsColumns and bookAuthorsJoin read the authors together with the
+// book, as arrays in position order. Use them with scanBookWithAuthors.
+const (
+	bookAuthorsColumns = "COALESCE(a.ids, '{}'), COALESCE(a.names, '{}')"
+	bookAuthorsJoin    = ` LEFT JOIN LATERAL (
+		SELECT array_agg(au.id ORDER BY ba.position, au.id) AS ids,
+			array_agg(au.name ORDER BY ba.position, au.id) AS names
+		FROM book_authors ba JOIN authors au ON au.id = ba.author_id
+		WHERE ba.book_id = books.id
+	) a ON true`
+)
+
+func scanBookWithAuthors(row scanner, book *domain.Book) error {
+	var ids []int64
+	var names []string
+	err := scanBook(row, book, pq.Array(&ids), pq.Array(&names))
+	if err != nil {
+		return err
+	}
+	for i := range ids {
+		book.Authors = append(book.Authors, domain.Author{ID: int(ids[i]), Name: names[i]})
+	}
+	return nil
 }
 
-func NewRepository(rawDB *sql.DB) *Repository {
-	return &Repository{db: rawDB}
+// bookKey names the book in errors: by ISBN when it has one, else by title.
+func bookKey(book domain.Book) string {
+	if book.ISBN != "" {
+		return book.ISBN
+	}
+	return book.Title
 }
 
 func (d Repository) SaveBookToDatabase(book domain.Book, ctx context.Context) (domain.Book, error) {
-	query := "INSERT INTO books (title, year_book, user_id) VALUES($1,$2,$3) RETURNING *"
-	err := d.db.QueryRowContext(ctx, query, book.Title, book.Year, book.UserID).Scan(&book.ID, &book.Title, &book.Year, &book.UserID)
+	query := `INSERT INTO books (title, year_book, user_id, isbn, edition, publisher, cover_url)
+		VALUES($1,$2,$3,NULLIF($4,''),$5,$6,$7) RETURNING ` + bookColumns
+	var saved domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		err := scanBook(tx.QueryRowContext(ctx, query, book.Title, book.Year, book.UserID, book.ISBN, book.Edition, book.Publisher, book.CoverURL), &saved)
+		if err != nil {
+			return dbError(err, "book", bookKey(book))
+		}
+		if len(book.Authors) > 0 {
+			if err := setBookAuthors(ctx, tx, saved.ID, book.Authors); err != nil {
+				return err
+			}
+			if saved, err = lockBook(ctx, tx, saved.ID); err != nil {
+				return err
+			}
+		}
+		return recordRevision(ctx, tx, domain.RevisionCreate, nil, &saved)
+	})
 	if err != nil {
 		return domain.Book{}, err
 	}
-	return book, nil
+	return saved, nil
 
 }
 
 func (d Repository) GetBookFromDatebase(id uint, ctx context.Context) (domain.Book, error) {
+	query := "SELECT " + bookColumns + " FROM books WHERE id = $1 AND deleted_at IS NULL"
+	return d.getBook(ctx, query, id)
+}
+
+// GetBookByIsbn expects the normalized 13-digit ISBN.
+func (d Repository) GetBookByIsbn(ctx context.Context, isbn string) (domain.Book, error) {
+	query := "SELECT " + bookColumns + " FROM books WHERE isbn = $1 AND deleted_at IS NULL"
+	return d.getBook(ctx, query, isbn)
+}
+
+func (d Repository) getBook(ctx context.Context, query string, key any) (domain.Book, error) {
 	var book domain.Book
-	query := "SELECT * FROM books WHERE id = $1"
-	err := d.db.QueryRowContext(ctx, query, id).Scan(&book.ID, &book.Title, &book.Year, &book.UserID)
+	err := scanBook(d.db.QueryRowContext(ctx, query, key), &book)
 	if err != nil {
+		return domain.Book{}, dbError(err, "book", key)
+	}
+	books := []domain.Book{book}
+	if err := d.loadAuthors(ctx, books); err != nil {
 		return domain.Book{}, err
 	}
-	return book, nil
+	return books[0], nil
+}
+
+// DeleteBookFromDatebase moves the book to the trash, only in the given
+// version unless version is 0. A missing or already deleted book is errs.NotFound.
+func (d Repository) DeleteBookFromDatebase(id uint, version int, ctx context.Context) error {
+	return d.deleteBook(ctx, int(id), version, 0)
 }
 
-func (d Repository) DeleteBookFromDatebase(id uint, ctx context.Context) error {
-	query := "DELETE FROM books WHERE id = $1"
-	_, err := d.db.ExecContext(ctx, query, id)
+// UpdateBookFromDatabase changes only the given fields (see domain.BookFields
+// and domain.BookAuthorsField) and returns the book as it is after the update.
+// A non-zero book.Version must match the stored one, otherwise the result is
+// errs.Aborted.
+func (d Repository) UpdateBookFromDatabase(book domain.Book, fields []string, ctx context.Context) (domain.Book, error) {
+	return d.updateBook(ctx, book, fields, 0)
+}
+
+// UpdateBookOwnedBy updates the book only if it belongs to userID.
+// It returns errs.NotFound for a missing book and errs.PermissionDenied for someone else's one.
+func (d Repository) UpdateBookOwnedBy(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	return d.updateBook(ctx, book, fields, userID)
+}
+
+func (d Repository) updateBook(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	var updated domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		before, err := lockBook(ctx, tx, book.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, book.Version); err != nil {
+			return err
+		}
+		updated, err = updateBookFields(ctx, tx, book, fields)
+		if err != nil {
+			return err
+		}
+		updated.Authors = before.Authors
+		if slices.Contains(fields, domain.BookAuthorsField) {
+			if err := setBookAuthors(ctx, tx, book.ID, book.Authors); err != nil {
+				return err
+			}
+			if updated, err = lockBook(ctx, tx, book.ID); err != nil {
+				return err
+			}
+		}
+		return recordRevision(ctx, tx, domain.RevisionUpdate, &before, &updated)
+	})
 	if err != nil {
-		return err
+		return domain.Book{}, err
 	}
-	return nil
+	return updated, nil
 }
 
-func (d Repository) UpdateBookFromDatabase(book domain.Book, ctx context.Context) error {
-	id := book.ID
-	title := book.Title
-	year := book.Year
-	query := "UPDATE books SET title = $1, year_book = $2 WHERE id = $3"
-	_, err := d.db.ExecContext(ctx, query, title, year, id)
+// updateBookFields writes the fields of the book and returns the new row
+// without authors.
+func updateBookFields(ctx context.Context, tx *sql.Tx, book domain.Book, fields []string) (domain.Book, error) {
+	set, args, err := bookUpdateSet(book, fields)
 	if err != nil {
-		return err
+		return domain.Book{}, err
+	}
+	args = append(args, book.ID)
+	query := fmt.Sprintf("UPDATE books SET %s WHERE id = $%d RETURNING %s", set, len(args), bookColumns)
+
+	var updated domain.Book
+	err = scanBook(tx.QueryRowContext(ctx, query, args...), &updated)
+	if err != nil {
+		return domain.Book{}, dbError(err, "book", bookKey(book))
+	}
+	return updated, nil
+}
+
+// bookUpdateSet builds the SET list for the fields, numbering placeholders from $1.
+// The version is bumped even when only the authors change.
+func bookUpdateSet(book domain.Book, fields []string) (string, []any, error) {
+	var set []string
+	var args []any
+	for _, field := range fields {
+		column, value, expr := field, any(nil), "%s = $%d"
+		switch field {
+		case "title":
+			value = book.Title
+		case "year":
+			column, value = "year_book", book.Year
+		case "isbn":
+			value, expr = book.ISBN, "%s = NULLIF($%d,'')"
+		case "edition":
+			value = book.Edition
+		case "publisher":
+			value = book.Publisher
+		case "cover_url":
+			value = book.CoverURL
+		case domain.BookAuthorsField:
+			continue // см. setBookAuthors
+		default:
+			return "", nil, errs.InvalidArgument("invalid update_mask",
+				errs.FieldViolation{Field: "update_mask", Description: "unknown field " + field})
+		}
+		args = append(args, value)
+		set = append(set, fmt.Sprintf(expr, column, len(args)))
+	}
+	set = append(set, "version = version + 1")
+	return strings.Join(set, ", "), args, nil
+}
+
+// DeleteBookOwnedBy moves the book to the trash only if it belongs to userID.
+func (d Repository) DeleteBookOwnedBy(ctx context.Context, id uint, version int, userID int) error {
+	return d.deleteBook(ctx, int(id), version, userID)
+}
+
+func (d Repository) deleteBook(ctx context.Context, id, version, userID int) error {
+	return d.transact(ctx, nil, func(tx *sql.Tx) error {
+		before, err := lockBook(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, version); err != nil {
+			return err
+		}
+		query := "UPDATE books SET deleted_at = now(), version = version + 1 WHERE id = $1 RETURNING " + bookColumns
+		var deleted domain.Book
+		if err := scanBook(tx.QueryRowContext(ctx, query, id), &deleted); err != nil {
+			return err
+		}
+		deleted.Authors = before.Authors
+		return recordRevision(ctx, tx, domain.RevisionDelete, &before, &deleted)
+	})
+}
+
+// lockBook reads the book with its authors, trash included, and locks it
+// until the end of the transaction.
+func lockBook(ctx context.Context, tx *sql.Tx, id int) (domain.Book, error) {
+	query := "SELECT " + bookColumns + ", " + bookAuthorsColumns + " FROM books" + bookAuthorsJoin +
+		" WHERE id = $1 FOR UPDATE OF books"
+	var book domain.Book
+	err := scanBookWithAuthors(tx.QueryRowContext(ctx, query, id), &book)
+	if err != nil {
+		return domain.Book{}, dbError(err, "book", id)
+	}
+	return book, nil
+}
+
+// checkBookWrite tells why the locked book cannot be written: it is in the
+// trash, belongs to someone else (unless userID is 0) or its version has
+// changed (unless version is 0).
+func checkBookWrite(book domain.Book, userID, version int) error {
+	if book.DeletedAt != nil {
+		return errs.NotFound("book", book.ID)
+	}
+	if userID != 0 && book.UserID != userID {
+		return errs.PermissionDenied("book", book.ID, "book belongs to another user")
+	}
+	if version != 0 && book.Version != version {
+		return errs.Aborted("book", book.ID, fmt.Sprintf("book was changed: version is %d, expected %d", book.Version, version))
 	}
 	return nil
 }
 
-func (d Repository) AllBooksFromDatabase(ctx context.Context) ([]domain.Book, error) {
+var bookOrderColumns = map[string]string{
+	"":      "id",
+	"id":    "id",
+	"title": "title",
+	"year":  "year_book",
+}
+
+// AllBooksFromDatabase returns one page of books using keyset pagination:
+// the next page starts right after query.After in the requested order.
+func (d Repository) AllBooksFromDatabase(ctx context.Context, query domain.BookQuery) ([]domain.Book, error) {
+	sqlQuery, args, err := bookListQuery("SELECT "+bookColumns+" FROM books", query)
+	if err != nil {
+		return nil, err
+	}
 
 	var books []domain.Book
-	query := "SELECT * FROM books"
-	row, err := d.db.QueryContext(ctx, query)
+	rows, err := d.db.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
-	for row.Next() {
+	defer rows.Close()
+	for rows.Next() {
 		var book domain.Book
-		row.Scan(&book.ID, &book.Title, &book.Year, &book.UserID)
+		err = scanBook(rows, &book)
+		if err != nil {
+			return nil, err
+		}
 		books = append(books, book)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
 
+	if err := d.loadAuthors(ctx, books); err != nil {
+		return nil, err
+	}
 	return books, nil
 }
 
+// StreamBooks calls fn for every book of the query while the rows are being
+// read, authors included. fn blocking (a slow client) holds the rows open.
+func (d Repository) StreamBooks(ctx context.Context, query domain.BookQuery, fn func(domain.Book) error) error {
+	sqlQuery, args, err := bookListQuery("SELECT "+bookColumns+", "+bookAuthorsColumns+" FROM books"+bookAuthorsJoin, query)
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var book domain.Book
+		if err := scanBookWithAuthors(rows, &book); err != nil {
+			return err
+		}
+		if err := fn(book); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// bookListQuery adds the filter, the keyset condition, the order and the
+// limit of the query to selectFrom.
+func bookListQuery(selectFrom string, query domain.BookQuery) (string, []any, error) {
+	column, ok := bookOrderColumns[query.OrderBy]
+	if !ok {
+		return "", nil, errs.InvalidArgument("invalid order_by", errs.FieldViolation{Field: "order_by", Description: "unknown field " + query.OrderBy})
+	}
+	where, args := bookFilterWhere(query.Filter)
+
+	direction, compare := "ASC", ">"
+	if query.Desc {
+		direction, compare = "DESC", "<"
+	}
+	if query.After != nil {
+		if column == "id" {
+			args = append(args, query.After.ID)
+			where = append(where, fmt.Sprintf("id %s $%d", compare, len(args)))
+		} else {
+			var value any = query.After.Title
+			if column == "year_book" {
+				value = query.After.Year
+			}
+			args = append(args, value, query.After.ID)
+			where = append(where, fmt.Sprintf("(%s, id) %s ($%d, $%d)", column, compare, len(args)-1, len(args)))
+		}
+	}
+
+	sqlQuery := selectFrom + whereClause(where)
+	if column == "id" {
+		sqlQuery += " ORDER BY id " + direction
+	} else {
+		sqlQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	}
+	if query.Limit > 0 {
+		args = append(args, query.Limit)
+		sqlQuery += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	return sqlQuery, args, nil
+}
+
+func (d Repository) CountBooks(ctx context.Context, filter domain.BookFilter) (int, error) {
+	where, args := bookFilterWhere(filter)
+	query := "SELECT count(*) FROM books" + whereClause(where)
+
+	var count int
+	err := d.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// bookFilterWhere returns the WHERE conditions for the filter, numbering
+// placeholders from $1.
+func bookFilterWhere(filter domain.BookFilter) ([]string, []any) {
+	where := []string{"deleted_at IS NULL"}
+	if filter.Deleted {
+		where[0] = "deleted_at IS NOT NULL"
+	}
+	var args []any
+	if filter.TitleContains != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.TitleContains)+"%")
+		where = append(where, fmt.Sprintf("title ILIKE $%d", len(args)))
+	}
+	if filter.YearMin != nil {
+		args = append(args, *filter.YearMin)
+		where = append(where, fmt.Sprintf("year_book >= $%d", len(args)))
+	}
+	if filter.YearMax != nil {
+		args = append(args, *filter.YearMax)
+		where = append(where, fmt.Sprintf("year_book <= $%d", len(args)))
+	}
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		where = append(where, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	return where, args
+}
+
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(where, " AND ")
+}
+
+// likeEscaper escapes the LIKE wildcards so they match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (d Repository) SaveUserToDatabase(ctx context.Context, user domain.User) (domain.User, error) {
-	query := "INSERT INTO users (email, password) VALUES($1,$2) RETURNING *"
-	err := d.db.QueryRowContext(ctx, query, user.Email, user.Password).Scan(&user.ID, &user.Email, &user.Password)
+	query := "INSERT INTO users (email, password) VALUES($1,$2) RETURNING user_id, email, password, is_admin"
+	err := d.db.QueryRowContext(ctx, query, user.Email, user.Password).Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
 	if err != nil {
-		return domain.User{}, err
+		return domain.User{}, dbError(err, "user", user.Email)
 	}
 	return user, nil
 }
 func (d Repository) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
 	var user domain.User
-	query := "SELECT * FROM users WHERE email = $1"
-	err := d.db.QueryRowContext(ctx, query, email).Scan(&user.ID, &user.Email, &user.Password)
+	query := "SELECT user_id, email, password, is_admin FROM users WHERE email = $1"
+	err := d.db.QueryRowContext(ctx, query, email).Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
 	if err != nil {
-		return domain.User{}, err
+		return domain.User{}, dbError(err, "user", email)
 	}
 	return user, nil
 }
+func (d Repository) UpdateUserPassword(ctx context.Context, userID int, password string) error {
+	query := "UPDATE users SET password = $1 WHERE user_id = $2"
+	_, err := d.db.ExecContext(ctx, query, password, userID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
 func (d Repository) SaveSessionToDatabase(ctx context.Context, session domain.Session) error {
-	query := "INSERT INTO sessions (user_id, token, ip, user_agent) VALUES($1,$2,$3,$4) RETURNING *"
-	err := d.db.QueryRowContext(ctx, query, session.UserID, session.Token, session.IP, session.UserAgent).Scan(&session.ID, &session.UserID, &session.Token, &session.IP, &session.UserAgent, &session.CreatedAt)
+	query := "INSERT INTO sessions (user_id, token, ip, user_agent) VALUES($1,$2,$3,$4)"
+	_, err := d.db.ExecContext(ctx, query, session.UserID, session.Token, session.IP, session.UserAgent)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// sessionAlive is the WHERE condition for sessions (aliased as s) that have not expired yet.
+// absolute and idle are placeholders for the TTLs in seconds, 0 means no limit.
+func sessionAlive(absolute, idle string) string {
+	return fmt.Sprintf(`(%[1]s::float8 = 0 OR s.created_at > now() - %[1]s::float8 * interval '1 second')
+		AND (%[2]s::float8 = 0 OR s.last_seen_at > now() - %[2]s::float8 * interval '1 second')`, absolute, idle)
+}
+
+// GetUserByToken returns the owner of a live session and marks the session as seen.
+// Expired and unknown tokens give errs.NotFound.
+func (d Repository) GetUserByToken(ctx context.Context, token string) (domain.User, error) {
+	var user domain.User
+	query := `UPDATE sessions s SET last_seen_at = now()
+		FROM users u
+		WHERE s.token = $1 AND u.user_id = s.user_id AND ` + sessionAlive("$2", "$3") + `
+		RETURNING u.user_id, u.email, u.password, u.is_admin`
+	err := d.db.QueryRowContext(ctx, query, token, d.sessionTTL.Absolute.Seconds(), d.sessionTTL.Idle.Seconds()).
+		Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
+	if err != nil {
+		return domain.User{}, dbError(err, "session", "")
+	}
+	return user, nil
+}
+
+func (d Repository) ListUserSessions(ctx context.Context, userID int) ([]domain.Session, error) {
+	var sessions []domain.Session
+	query := `SELECT s.id, s.user_id, s.token, s.ip, s.user_agent, s.created_at, s.last_seen_at
+		FROM sessions s
+		WHERE s.user_id = $1 AND ` + sessionAlive("$2", "$3") + `
+		ORDER BY s.last_seen_at DESC`
+	rows, err := d.db.QueryContext(ctx, query, userID, d.sessionTTL.Absolute.Seconds(), d.sessionTTL.Idle.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var session domain.Session
+		err = rows.Scan(&session.ID, &session.UserID, &session.Token, &session.IP, &session.UserAgent, &session.CreatedAt, &session.LastSeenAt)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+func (d Repository) DeleteSessionByToken(ctx context.Context, token string) error {
+	query := "DELETE FROM sessions WHERE token = $1"
+	_, err := d.db.ExecContext(ctx, query, token)
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func (d Repository) GetUserByToken(ctx context.Context, token string) (int, error) {
-	var session domain.Session
-	query := "SELECT * FROM sessions WHERE token = $1"
-	err := d.db.QueryRowContext(ctx, query, token).Scan(&session.ID, &session.UserID, &session.Token, &session.IP, &session.UserAgent, &session.CreatedAt)
+
+// DeleteUserSession deletes one session of the user, errs.NotFound if there is no such session.
+func (d Repository) DeleteUserSession(ctx context.Context, userID int, sessionID int) error {
+	query := "DELETE FROM sessions WHERE id = $1 AND user_id = $2"
+	res, err := d.db.ExecContext(ctx, query, sessionID, userID)
+	if err != nil {
+		return err
+	}
+	return affectedOrNotFound(res, "session", sessionID)
+}
+
+// DeleteUserSessions deletes every session of the user except the one with exceptToken.
+func (d Repository) DeleteUserSessions(ctx context.Context, userID int, exceptToken string) (int64, error) {
+	query := "DELETE FROM sessions WHERE user_id = $1 AND token <> $2"
+	res, err := d.db.ExecContext(ctx, query, userID, exceptToken)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (d Repository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	query := "DELETE FROM sessions s WHERE NOT (" + sessionAlive("$1", "$2") + ")"
+	res, err := d.db.ExecContext(ctx, query, d.sessionTTL.Absolute.Seconds(), d.sessionTTL.Idle.Seconds())
 	if err != nil {
 		return 0, err
 	}
-	return session.UserID, nil
+	return res.RowsAffected()
 }
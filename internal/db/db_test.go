@@ -0,0 +1,53 @@
+package db_test
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/db"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/repotest"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+)
+
+// TestRepository runs the suite on the Postgres database from
+// TEST_BOOKSERVER_DSN. Its tables are emptied before every subtest, so it must
+// not be a database with data worth keeping.
+func TestRepository(t *testing.T) {
+	dsn := os.Getenv("TEST_BOOKSERVER_DSN")
+	if dsn == "" {
+		// В CI база есть всегда, пропуск там значил бы, что Postgres не проверен.
+		if os.Getenv("CI") != "" {
+			t.Fatal("TEST_BOOKSERVER_DSN is not set")
+		}
+		t.Skip("TEST_BOOKSERVER_DSN is not set")
+	}
+
+	migrator, err := migrate.New("file://../../migrations", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer migrator.Close()
+	if err := migrator.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	repotest.Run(t, func(t *testing.T) api.Repository {
+		query := "TRUNCATE books, users, sessions, authors, book_authors, book_revisions RESTART IDENTITY CASCADE"
+		if _, err := conn.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		return db.NewRepository(conn, domain.SessionTTL{})
+	})
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"bookserver_git/internal/errs"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// constraintFields maps unique constraints to the request field that broke them.
+var constraintFields = map[string]string{
+	"books_title_key":         "title",
+	"books_isbn_key":          "isbn",
+	"books_title_edition_key": "title",
+	"users_email_key":         "email",
+	"authors_name_key":        "name",
+}
+
+// dbError turns driver errors into errs.Error about the given resource.
+// Errors it does not recognise are returned as is.
+func dbError(err error, resource string, id any) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return errs.NotFound(resource, id).Wrap(err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		e := errs.AlreadyExists(resource, id).Wrap(err)
+		if field, ok := constraintFields[pqErr.Constraint]; ok {
+			e.Fields = []errs.FieldViolation{{Field: field, Description: "already taken"}}
+		}
+		return e
+	}
+	// Ссылка на несуществующую запись, например author_id в book_authors.
+	if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+		return errs.NotFound(resource, id).Wrap(err)
+	}
+	return err
+}
+
+// affectedOrNotFound gives errs.NotFound when the statement did not touch any row.
+func affectedOrNotFound(res sql.Result, resource string, id any) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errs.NotFound(resource, id)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"bookserver_git/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// exportFetchSize is how many rows one FETCH takes from the cursor.
+const exportFetchSize = 500
+
+// ExportBooks calls fn for every book that matches the filter, ordered by ID.
+// Rows are read from a server-side cursor in a read-only snapshot, so only
+// one FETCH is kept in memory. An error from fn stops the export.
+func (d Repository) ExportBooks(ctx context.Context, filter domain.BookFilter, fn func(domain.Book) error) error {
+	export := func(tx *sql.Tx) error {
+		where, args := bookFilterWhere(filter)
+		query := "DECLARE export_books NO SCROLL CURSOR FOR SELECT " + bookColumns + ", " + bookAuthorsColumns +
+			" FROM books" + bookAuthorsJoin + whereClause(where) + " ORDER BY id"
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+
+		for {
+			n, err := fetchExported(ctx, tx, fn)
+			if err != nil {
+				return err
+			}
+			if n < exportFetchSize {
+				break
+			}
+		}
+		_, err := tx.ExecContext(ctx, "CLOSE export_books")
+		return err
+	}
+
+	if d.tx != nil {
+		return export(d.tx)
+	}
+	// Не через transact: fn уже отдал книги, повторять экспорт нельзя.
+	return d.transactOnce(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, export)
+}
+
+// fetchExported reads the next rows of the cursor and returns how many there were.
+func fetchExported(ctx context.Context, tx *sql.Tx, fn func(domain.Book) error) (int, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("FETCH FORWARD %d FROM export_books", exportFetchSize))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var book domain.Book
+		if err := scanBookWithAuthors(rows, &book); err != nil {
+			return n, err
+		}
+		if err := fn(book); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rows.Err()
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"bookserver_git/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// ImportBooks inserts the books in one transaction. Every book goes under its
+// own savepoint, so a failed book is rolled back alone and the rest are kept.
+// Authors with ID 0 are found or created by name. With dryRun everything is
+// rolled back, but the results are the same as for a real import.
+func (d Repository) ImportBooks(ctx context.Context, books []domain.Book, dryRun bool) ([]domain.ImportResult, error) {
+	var results []domain.ImportResult
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		// Откат до savepoint, а не всей транзакции: внутри WithTx она не наша.
+		if dryRun {
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT import_dry_run"); err != nil {
+				return err
+			}
+		}
+		results = make([]domain.ImportResult, len(books))
+		for i, book := range books {
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT import_book"); err != nil {
+				return err
+			}
+			id, err := importBook(ctx, tx, book)
+			if err != nil {
+				if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_book"); rbErr != nil {
+					return rbErr
+				}
+				results[i].Err = err
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT import_book"); err != nil {
+				return err
+			}
+			results[i].ID = id
+		}
+		if dryRun {
+			_, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_dry_run")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// importBook returns 0 when an equal book already exists.
+func importBook(ctx context.Context, tx *sql.Tx, book domain.Book) (int, error) {
+	query := `INSERT INTO books (title, year_book, user_id, isbn, edition, publisher, cover_url)
+		VALUES($1,$2,$3,NULLIF($4,''),$5,$6,$7)
+		ON CONFLICT DO NOTHING
+		RETURNING id`
+	var id int
+	err := tx.QueryRowContext(ctx, query, book.Title, book.Year, book.UserID, book.ISBN, book.Edition, book.Publisher, book.CoverURL).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, dbError(err, "book", bookKey(book))
+	}
+
+	query = "INSERT INTO book_authors (book_id, author_id, position) VALUES($1,$2,$3) ON CONFLICT DO NOTHING"
+	for position, author := range book.Authors {
+		if author.ID == 0 {
+			err = tx.QueryRowContext(ctx, ensureAuthorQuery, author.Name).Scan(&author.ID, &author.Name)
+			if err != nil {
+				return 0, dbError(err, "author", author.Name)
+			}
+		}
+		_, err = tx.ExecContext(ctx, query, id, author.ID, position)
+		if err != nil {
+			return 0, dbError(err, "author", author.ID)
+		}
+	}
+
+	imported, err := lockBook(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+	if err := recordRevision(ctx, tx, domain.RevisionCreate, nil, &imported); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
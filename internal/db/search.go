@@ -0,0 +1,50 @@
+package db
+
+import (
+	"bookserver_git/internal/domain"
+	"context"
+)
+
+// SearchBooks combines full-text search over the generated books.search column
+// with pg_trgm similarity, so titles with typos are still found.
+func (d Repository) SearchBooks(ctx context.Context, text string, limit int) ([]domain.BookSearchResult, error) {
+	query := `SELECT ` + bookColumns + `,
+			ts_rank(search, q) + greatest(similarity(title, $1), word_similarity($1, title)) AS rank,
+			ts_headline('simple', title, q, $3) AS snippet
+		FROM books, websearch_to_tsquery('simple', $1) AS q
+		WHERE (search @@ q OR title % $1 OR $1 <% title) AND deleted_at IS NULL
+		ORDER BY rank DESC, id
+		LIMIT $2`
+	options := "StartSel=" + domain.SnippetStart + ", StopSel=" + domain.SnippetStop + ", HighlightAll=true"
+	rows, err := d.db.QueryContext(ctx, query, text, limit, options)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.BookSearchResult
+	for rows.Next() {
+		var result domain.BookSearchResult
+		err = scanBook(rows, &result.Book, &result.Rank, &result.Snippet)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	books := make([]domain.Book, len(results))
+	for i := range results {
+		books[i] = results[i].Book
+	}
+	if err := d.loadAuthors(ctx, books); err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Book = books[i]
+	}
+	return results, nil
+}
@@ -0,0 +1,6 @@
+package domain
+
+type Author struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
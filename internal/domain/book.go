@@ -1,12 +1,92 @@
 package domain
 
+import "time"
+
 type Book struct {
 	// ID        uint `gorm:"primarykey"`
 	// CreatedAt time.Time
 	// UpdatedAt time.Time
-	ID    int    `json:"id"`
-	Title string `json:"title"`
-	// Authors []string `json:"authors"`
-	Year   int `json:"year"`
-	UserID int `json:"userID"`
+	ID        int      `json:"id"`
+	Title     string   `json:"title"`
+	Authors   []Author `json:"authors"`
+	Year      int      `json:"year"`
+	UserID    int      `json:"userID"`
+	ISBN      string   `json:"isbn"` // ISBN-13 без дефисов или пусто
+	Edition   string   `json:"edition"`
+	Publisher string   `json:"publisher"`
+	CoverURL  string   `json:"coverURL"`
+	// Version растёт на каждой записи. В запросах на изменение 0 значит
+	// «любая версия».
+	Version int `json:"version"`
+	// DeletedAt задан у книги в корзине.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+// BookFields are the fields of Book that UpdateBook changes selectively.
+var BookFields = []string{"title", "year", "isbn", "edition", "publisher", "cover_url"}
+
+// BookAuthorsField among the fields of an update replaces the authors of the
+// book with Book.Authors, taken by ID, in the same write as the other fields.
+const BookAuthorsField = "authors"
+
+type BookFilter struct {
+	TitleContains string
+	YearMin       *int
+	YearMax       *int
+	OwnerID       int
+	// Deleted выбирает книги из корзины вместо обычных.
+	Deleted bool
+}
+
+// BookQuery describes one page of books. Books go in OrderBy order with ID as
+// the tie-breaker; After is the last book of the previous page.
+type BookQuery struct {
+	Filter  BookFilter
+	OrderBy string // "id", "title" или "year"
+	Desc    bool
+	After   *Book
+	Limit   int
+}
+
+type BookSearchResult struct {
+	Book Book
+	Rank float64
+	// Snippet — название, найденные слова в нём между SnippetStart и
+	// SnippetStop. Название не экранировано, HTML из него делает API.
+	Snippet string
+}
+
+// Метки найденных слов в BookSearchResult.Snippet. Управляющие символы в
+// названиях не пишут, а попавший туда даст лишний <b>, но не разметку.
+const (
+	SnippetStart = "\x01"
+	SnippetStop  = "\x02"
+)
+
+// ImportResult is the outcome of one book of an import batch. ID is 0 and Err
+// is nil when an equal book (same ISBN, or title and edition) already exists.
+type ImportResult struct {
+	ID  int
+	Err error
+}
+
+// Действия, которые пишутся в историю книги.
+const (
+	RevisionCreate   = "create"
+	RevisionUpdate   = "update"
+	RevisionDelete   = "delete"
+	RevisionUndelete = "undelete"
+	RevisionRevert   = "revert"
+)
+
+// BookRevision is one change of a book. Before is nil for a created book.
+type BookRevision struct {
+	ID        int
+	BookID    int
+	Version   int // версия книги после изменения
+	Action    string
+	ActorID   int // 0, если изменение сделано не пользователем
+	ChangedAt time.Time
+	Before    *Book
+	After     *Book
 }
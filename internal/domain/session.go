@@ -3,10 +3,18 @@ package domain
 import "time"
 
 type Session struct {
-	ID        int
-	UserID    int
-	Token     string
-	IP        string
-	UserAgent string
-	CreatedAt time.Time
+	ID         int
+	UserID     int
+	Token      string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// SessionTTL limits how long a session token stays valid.
+// Absolute counts from CreatedAt, Idle from LastSeenAt; zero disables the limit.
+type SessionTTL struct {
+	Absolute time.Duration
+	Idle     time.Duration
 }
@@ -4,4 +4,5 @@ type User struct {
 	ID       int
 	Email    string
 	Password string
+	IsAdmin  bool
 }
@@ -0,0 +1,120 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an error independently of the transport; internal/api turns
+// it into a gRPC status code and the gateway into an HTTP status.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindAlreadyExists
+	KindInvalidArgument
+	KindUnauthenticated
+	KindPermissionDenied
+	// KindAborted: запись не прошла из-за параллельного изменения, например
+	// не совпала ожидаемая версия.
+	KindAborted
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindAlreadyExists:
+		return "already exists"
+	case KindInvalidArgument:
+		return "invalid argument"
+	case KindUnauthenticated:
+		return "unauthenticated"
+	case KindPermissionDenied:
+		return "permission denied"
+	case KindAborted:
+		return "aborted"
+	}
+	return "internal"
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type Error struct {
+	Kind    Kind
+	Message string
+	// Resource и ID описывают объект, к которому относится ошибка ("book", "42").
+	Resource string
+	ID       string
+	Fields   []FieldViolation
+	Err      error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Text() + ": " + e.Err.Error()
+	}
+	return e.Text()
+}
+
+// Text is the message without the wrapped cause, safe to show to clients.
+func (e *Error) Text() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Resource != "" {
+		return fmt.Sprintf("%s %s: %s", e.Resource, e.ID, e.Kind)
+	}
+	return e.Kind.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap keeps the underlying cause for logs and errors.Is.
+func (e *Error) Wrap(err error) *Error {
+	e.Err = err
+	return e
+}
+
+func NotFound(resource string, id any) *Error {
+	return &Error{Kind: KindNotFound, Resource: resource, ID: fmt.Sprint(id)}
+}
+
+func AlreadyExists(resource string, id any) *Error {
+	return &Error{Kind: KindAlreadyExists, Resource: resource, ID: fmt.Sprint(id)}
+}
+
+func PermissionDenied(resource string, id any, message string) *Error {
+	return &Error{Kind: KindPermissionDenied, Resource: resource, ID: fmt.Sprint(id), Message: message}
+}
+
+func InvalidArgument(message string, fields ...FieldViolation) *Error {
+	return &Error{Kind: KindInvalidArgument, Message: message, Fields: fields}
+}
+
+func Aborted(resource string, id any, message string) *Error {
+	return &Error{Kind: KindAborted, Resource: resource, ID: fmt.Sprint(id), Message: message}
+}
+
+func Unauthenticated(message string) *Error {
+	return &Error{Kind: KindUnauthenticated, Message: message}
+}
+
+// KindOf returns KindInternal for errors that did not come from this package.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
+
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
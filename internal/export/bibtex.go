@@ -0,0 +1,59 @@
+package export
+
+import (
+	"bookserver_git/internal/domain"
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type bibtexWriter struct {
+	w *bufio.Writer
+}
+
+func newBibTeXWriter(w io.Writer) (Writer, error) {
+	return bibtexWriter{w: bufio.NewWriter(w)}, nil
+}
+
+// WriteBook writes a @book entry keyed by the book ID, empty fields are left out.
+// The cover is not written: BibTeX has no field for it, url is the work itself.
+func (b bibtexWriter) WriteBook(book domain.Book) error {
+	fmt.Fprintf(b.w, "@book{book%d,\n", book.ID)
+	b.field("title", book.Title)
+	b.field("author", strings.Join(authorNames(book), " and "))
+	if book.Year != 0 {
+		b.field("year", strconv.Itoa(book.Year))
+	}
+	b.field("edition", book.Edition)
+	b.field("publisher", book.Publisher)
+	b.field("isbn", book.ISBN)
+	_, err := b.w.WriteString("}\n\n")
+	return err
+}
+
+func (b bibtexWriter) field(name, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(b.w, "  %s = {%s},\n", name, bibtexEscaper.Replace(value))
+}
+
+func (b bibtexWriter) Close() error {
+	return b.w.Flush()
+}
+
+// bibtexEscaper escapes the characters that are special in LaTeX.
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	"{", `\{`,
+	"}", `\}`,
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+	"~", `\textasciitilde{}`,
+	"^", `\textasciicircum{}`,
+)
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"bookserver_git/internal/domain"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Writer writes books one by one. Close finishes the document, it does not
+// close the underlying io.Writer.
+type Writer interface {
+	WriteBook(book domain.Book) error
+	Close() error
+}
+
+type Format struct {
+	Name        string
+	ContentType string
+	Extension   string
+	newWriter   func(w io.Writer) (Writer, error)
+}
+
+func (f Format) NewWriter(w io.Writer) (Writer, error) {
+	return f.newWriter(w)
+}
+
+var formats = map[string]Format{
+	"csv":     {Name: "csv", ContentType: "text/csv; charset=utf-8", Extension: "csv", newWriter: newCSVWriter},
+	"jsonl":   {Name: "jsonl", ContentType: "application/x-ndjson", Extension: "jsonl", newWriter: newJSONLWriter},
+	"bibtex":  {Name: "bibtex", ContentType: "application/x-bibtex; charset=utf-8", Extension: "bib", newWriter: newBibTeXWriter},
+	"marcxml": {Name: "marcxml", ContentType: "application/marcxml+xml", Extension: "xml", newWriter: newMARCXMLWriter},
+}
+
+func Lookup(name string) (Format, bool) {
+	f, ok := formats[name]
+	return f, ok
+}
+
+// AuthorsSeparator joins author names in CSV, ImportBooks splits on it by default.
+const AuthorsSeparator = "; "
+
+// csvHeader uses the column names ImportBooks expects, so the file can be
+// imported back as is.
+var csvHeader = []string{"id", "title", "year", "authors", "isbn", "edition", "publisher", "cover_url"}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (Writer, error) {
+	c := csvWriter{w: csv.NewWriter(w)}
+	if err := c.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c csvWriter) WriteBook(book domain.Book) error {
+	year := ""
+	if book.Year != 0 {
+		year = strconv.Itoa(book.Year)
+	}
+	return c.w.Write([]string{
+		strconv.Itoa(book.ID),
+		book.Title,
+		year,
+		strings.Join(authorNames(book), AuthorsSeparator),
+		book.ISBN,
+		book.Edition,
+		book.Publisher,
+		book.CoverURL,
+	})
+}
+
+func (c csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonlBook follows the JSON of Book in the HTTP API.
+type jsonlBook struct {
+	ID        int          `json:"id"`
+	Title     string       `json:"title"`
+	Year      int          `json:"year,omitempty"`
+	UserID    int          `json:"userId"`
+	Authors   []jsonAuthor `json:"authors,omitempty"`
+	ISBN      string       `json:"isbn,omitempty"`
+	Edition   string       `json:"edition,omitempty"`
+	Publisher string       `json:"publisher,omitempty"`
+	CoverURL  string       `json:"coverUrl,omitempty"`
+}
+
+type jsonAuthor struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) (Writer, error) {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return jsonlWriter{enc: enc}, nil
+}
+
+func (j jsonlWriter) WriteBook(book domain.Book) error {
+	line := jsonlBook{
+		ID:        book.ID,
+		Title:     book.Title,
+		Year:      book.Year,
+		UserID:    book.UserID,
+		ISBN:      book.ISBN,
+		Edition:   book.Edition,
+		Publisher: book.Publisher,
+		CoverURL:  book.CoverURL,
+	}
+	for _, author := range book.Authors {
+		line.Authors = append(line.Authors, jsonAuthor{ID: author.ID, Name: author.Name})
+	}
+	// Encode сам добавляет перевод строки.
+	return j.enc.Encode(line)
+}
+
+func (j jsonlWriter) Close() error {
+	return nil
+}
+
+func authorNames(book domain.Book) []string {
+	names := make([]string, len(book.Authors))
+	for i, author := range book.Authors {
+		names[i] = author.Name
+	}
+	return names
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"bookserver_git/internal/domain"
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+const marcNamespace = "http://www.loc.gov/MARC21/slim"
+
+// marcLeader: новая запись (n), текст (a), монография (m), UTF-8 (a).
+// Длины и адреса нули, в MARCXML они не нужны.
+const marcLeader = "00000nam a2200000 i 4500"
+
+type marcRecord struct {
+	XMLName       xml.Name           `xml:"record"`
+	Leader        string             `xml:"leader"`
+	ControlFields []marcControlField `xml:"controlfield"`
+	DataFields    []marcDataField    `xml:"datafield"`
+}
+
+type marcControlField struct {
+	Tag   string `xml:"tag,attr"`
+	Value string `xml:",chardata"`
+}
+
+type marcDataField struct {
+	Tag       string         `xml:"tag,attr"`
+	Ind1      string         `xml:"ind1,attr"`
+	Ind2      string         `xml:"ind2,attr"`
+	Subfields []marcSubfield `xml:"subfield"`
+}
+
+type marcSubfield struct {
+	Code  string `xml:"code,attr"`
+	Value string `xml:",chardata"`
+}
+
+type marcxmlWriter struct {
+	w   io.Writer
+	enc *xml.Encoder
+}
+
+func newMARCXMLWriter(w io.Writer) (Writer, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err := enc.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "collection"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: marcNamespace}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marcxmlWriter{w: w, enc: enc}, nil
+}
+
+func (m marcxmlWriter) WriteBook(book domain.Book) error {
+	return m.enc.Encode(marcRecordOf(book))
+}
+
+func (m marcxmlWriter) Close() error {
+	err := m.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "collection"}})
+	if err != nil {
+		return err
+	}
+	if err := m.enc.Flush(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(m.w, "\n")
+	return err
+}
+
+// marcRecordOf maps the book to MARC 21 bibliographic fields: 001 ID,
+// 020 ISBN, 100/700 authors, 245 title, 250 edition, 264 publication,
+// 856 cover.
+func marcRecordOf(book domain.Book) marcRecord {
+	record := marcRecord{
+		Leader:        marcLeader,
+		ControlFields: []marcControlField{{Tag: "001", Value: strconv.Itoa(book.ID)}},
+	}
+	add := func(tag, ind1, ind2 string, subfields ...marcSubfield) {
+		record.DataFields = append(record.DataFields, marcDataField{Tag: tag, Ind1: ind1, Ind2: ind2, Subfields: subfields})
+	}
+
+	if book.ISBN != "" {
+		add("020", " ", " ", marcSubfield{Code: "a", Value: book.ISBN})
+	}
+	// Первый индикатор 245: есть ли основной автор в 100.
+	titleInd1 := "0"
+	if len(book.Authors) > 0 {
+		add("100", "1", " ", marcSubfield{Code: "a", Value: book.Authors[0].Name})
+		titleInd1 = "1"
+	}
+	add("245", titleInd1, "0", marcSubfield{Code: "a", Value: book.Title})
+	if book.Edition != "" {
+		add("250", " ", " ", marcSubfield{Code: "a", Value: book.Edition})
+	}
+
+	var publication []marcSubfield
+	if book.Publisher != "" {
+		publication = append(publication, marcSubfield{Code: "b", Value: book.Publisher})
+	}
+	if book.Year != 0 {
+		publication = append(publication, marcSubfield{Code: "c", Value: strconv.Itoa(book.Year)})
+	}
+	if len(publication) > 0 {
+		add("264", " ", "1", publication...)
+	}
+
+	for _, author := range book.Authors[min(1, len(book.Authors)):] {
+		add("700", "1", " ", marcSubfield{Code: "a", Value: author.Name})
+	}
+	if book.CoverURL != "" {
+		add("856", "4", "2", marcSubfield{Code: "u", Value: book.CoverURL}, marcSubfield{Code: "3", Value: "Cover image"})
+	}
+	return record
+}
@@ -0,0 +1,86 @@
+// Package health keeps the grpc.health.v1 status of the server in line with
+// whether its dependencies, like the database, are usable.
+package health
+
+import (
+	"bookserver_git/internal/worker"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check returns why a dependency is not usable, or nil.
+type Check func(ctx context.Context) error
+
+// Checker runs the checks and sets the status of the whole server, the ""
+// service, to SERVING when all of them pass and NOT_SERVING otherwise.
+type Checker struct {
+	server  *health.Server
+	timeout time.Duration
+	checks  map[string]Check
+	last    healthpb.HealthCheckResponse_ServingStatus
+}
+
+// NewChecker creates a Checker for server. A timeout of 0 does not limit the
+// checks. Without checks the server is always SERVING.
+func NewChecker(server *health.Server, timeout time.Duration, checks map[string]Check) *Checker {
+	return &Checker{server: server, timeout: timeout, checks: checks}
+}
+
+// Run checks right away and then once per interval until ctx is done.
+func (c *Checker) Run(ctx context.Context, log *slog.Logger, interval time.Duration) {
+	c.check(ctx, log)
+	worker.Every(ctx, log, "readiness check", interval, func(ctx context.Context) error {
+		c.check(ctx, log)
+		return nil
+	})
+}
+
+func (c *Checker) check(ctx context.Context, log *slog.Logger) {
+	checkCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	names := make([]string, 0, len(c.checks))
+	for name := range c.checks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var errs []error
+	for _, name := range names {
+		if err := c.checks[name](checkCtx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	// Проверка, прерванная остановкой сервера, ничего не говорит о зависимостях.
+	if ctx.Err() != nil {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	err := errors.Join(errs...)
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.server.SetServingStatus("", status)
+
+	// Пишем в лог только смену статуса, а не каждую проверку.
+	if status == c.last {
+		return
+	}
+	c.last = status
+	if err != nil {
+		log.Error("Server not ready", slog.Any("error", err))
+	} else {
+		log.Info("Server ready")
+	}
+}
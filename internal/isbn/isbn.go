@@ -0,0 +1,99 @@
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrLength   = errors.New("isbn must have 10 or 13 digits")
+	ErrChecksum = errors.New("isbn checksum mismatch")
+	ErrChars    = errors.New("isbn may contain only digits, hyphens, spaces and a final X")
+)
+
+// Normalize validates an ISBN-10 or ISBN-13 written with or without hyphens
+// and returns it as 13 digits without separators.
+func Normalize(s string) (string, error) {
+	digits, err := strip(s)
+	if err != nil {
+		return "", err
+	}
+	switch len(digits) {
+	case 10:
+		if !valid10(digits) {
+			return "", ErrChecksum
+		}
+		return To13(digits)
+	case 13:
+		if !valid13(digits) {
+			return "", ErrChecksum
+		}
+		return digits, nil
+	}
+	return "", ErrLength
+}
+
+// To13 converts a valid ISBN-10 (without separators) to ISBN-13.
+func To13(isbn10 string) (string, error) {
+	if len(isbn10) != 10 || !valid10(isbn10) {
+		return "", ErrChecksum
+	}
+	body := "978" + isbn10[:9]
+	return body + string(check13(body)), nil
+}
+
+func strip(s string) (string, error) {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '-' || r == ' ':
+		case (r == 'X' || r == 'x') && i == len(s)-1:
+			b.WriteByte('X')
+		default:
+			return "", ErrChars
+		}
+	}
+	return b.String(), nil
+}
+
+func valid10(s string) bool {
+	if strings.IndexByte(s[:9], 'X') >= 0 {
+		return false
+	}
+	return check10(s[:9]) == s[9]
+}
+
+func valid13(s string) bool {
+	if strings.IndexByte(s, 'X') >= 0 {
+		return false
+	}
+	return check13(s[:12]) == s[12]
+}
+
+// check10 computes the check digit of the first 9 digits of an ISBN-10.
+func check10(body string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(body[i]-'0') * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// check13 computes the check digit of the first 12 digits of an ISBN-13.
+func check13(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(body[i]-'0') * weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
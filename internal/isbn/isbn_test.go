@@ -0,0 +1,50 @@
+package isbn_test
+
+import (
+	"bookserver_git/internal/isbn"
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{in: "0306406152", want: "9780306406157"},
+		{in: "0-306-40615-2", want: "9780306406157"},
+		{in: "0 306 40615 2", want: "9780306406157"},
+		{in: "9780306406157", want: "9780306406157"},
+		{in: "978-0-306-40615-7", want: "9780306406157"},
+		{in: "979-10-90636-07-1", want: "9791090636071"},
+		{in: "0-8044-2957-X", want: "9780804429573"},
+		{in: "080442957x", want: "9780804429573"},
+
+		{in: "0-306-40615-3", err: isbn.ErrChecksum},
+		{in: "978-0-306-40615-8", err: isbn.ErrChecksum},
+		{in: "0-306-40615-X", err: isbn.ErrChecksum},
+		{in: "978030640615X", err: isbn.ErrChecksum},
+		{in: "", err: isbn.ErrLength},
+		{in: "030640615", err: isbn.ErrLength},
+		{in: "97803064061570", err: isbn.ErrLength},
+		{in: "0-306-4O615-2", err: isbn.ErrChars},
+		{in: "X306406152", err: isbn.ErrChars},
+		{in: "0306406152.", err: isbn.ErrChars},
+	}
+	for _, tt := range tests {
+		got, err := isbn.Normalize(tt.in)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("Normalize(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestTo13(t *testing.T) {
+	if got, err := isbn.To13("080442957X"); got != "9780804429573" || err != nil {
+		t.Errorf("To13(080442957X) = %q, %v", got, err)
+	}
+	if _, err := isbn.To13("0804429579"); !errors.Is(err, isbn.ErrChecksum) {
+		t.Errorf("To13 of a wrong check digit: got error %v, want %v", err, isbn.ErrChecksum)
+	}
+}
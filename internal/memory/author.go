@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// authorByName follows authors_name_key, names are compared case-insensitively.
+func (s *state) authorByName(name string) (domain.Author, bool) {
+	for _, author := range s.authors {
+		if strings.EqualFold(author.Name, name) {
+			return author, true
+		}
+	}
+	return domain.Author{}, false
+}
+
+func (s *state) ensureAuthor(name string) domain.Author {
+	if author, ok := s.authorByName(name); ok {
+		return author
+	}
+	s.lastAuthorID++
+	author := domain.Author{ID: s.lastAuthorID, Name: name}
+	s.authors[author.ID] = author
+	return author
+}
+
+func (r *Repository) SaveAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	var saved domain.Author
+	err := r.update(func(st *state) error {
+		if _, ok := st.authorByName(author.Name); ok {
+			e := errs.AlreadyExists("author", author.Name)
+			e.Fields = []errs.FieldViolation{{Field: "name", Description: "already taken"}}
+			return e
+		}
+		saved = st.ensureAuthor(author.Name)
+		return nil
+	})
+	return saved, err
+}
+
+func (r *Repository) GetAuthor(ctx context.Context, id int) (domain.Author, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	author, ok := st.authors[id]
+	if !ok {
+		return domain.Author{}, errs.NotFound("author", id)
+	}
+	return author, nil
+}
+
+func (r *Repository) ListAuthors(ctx context.Context, nameContains string, afterID int, limit int) ([]domain.Author, error) {
+	st, unlock := r.lock()
+	defer unlock()
+
+	var authors []domain.Author
+	for _, author := range st.authors {
+		if author.ID > afterID && strings.Contains(strings.ToLower(author.Name), strings.ToLower(nameContains)) {
+			authors = append(authors, author)
+		}
+	}
+	slices.SortFunc(authors, func(a, b domain.Author) int { return a.ID - b.ID })
+	if len(authors) > limit {
+		authors = authors[:limit]
+	}
+	return authors, nil
+}
+
+func (r *Repository) EnsureAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	authors := make([]domain.Author, len(names))
+	err := r.update(func(st *state) error {
+		for i, name := range names {
+			authors[i] = st.ensureAuthor(name)
+		}
+		return nil
+	})
+	return authors, err
+}
+
+// setBookAuthors replaces the authors of the book keeping the given order,
+// the version and the revision are up to the caller.
+func (s *state) setBookAuthors(bookID int, authors []domain.Author) error {
+	var links []bookAuthor
+	for position, author := range authors {
+		if _, ok := s.authors[author.ID]; !ok {
+			return errs.NotFound("author", author.ID)
+		}
+		if !slices.ContainsFunc(links, func(link bookAuthor) bool { return link.AuthorID == author.ID }) {
+			links = append(links, bookAuthor{AuthorID: author.ID, Position: position})
+		}
+	}
+	s.bookAuthors[bookID] = links
+	return nil
+}
+
+func (s *state) bumpVersion(bookID int) {
+	book := s.books[bookID]
+	book.Version++
+	s.books[bookID] = book
+}
+
+func (r *Repository) MergeAuthors(ctx context.Context, targetID int, sourceIDs []int) (domain.Author, error) {
+	var target domain.Author
+	err := r.update(func(st *state) error {
+		var ok bool
+		target, ok = st.authors[targetID]
+		if !ok {
+			return errs.NotFound("author", targetID)
+		}
+		found := 0
+		for _, author := range st.authors {
+			if slices.Contains(sourceIDs, author.ID) {
+				found++
+			}
+		}
+		if found != len(sourceIDs) {
+			return errs.NotFound("author", fmt.Sprint(sourceIDs))
+		}
+
+		var befores []domain.Book
+		for _, bookID := range slices.Sorted(maps.Keys(st.bookAuthors)) {
+			links := st.bookAuthors[bookID]
+			if !slices.ContainsFunc(links, func(link bookAuthor) bool { return slices.Contains(sourceIDs, link.AuthorID) }) {
+				continue
+			}
+			before, _ := st.book(bookID)
+			befores = append(befores, before)
+			st.bumpVersion(bookID)
+
+			// Книга, у которой уже есть target, остаётся со своей позицией.
+			var merged []bookAuthor
+			position, hasTarget := -1, false
+			for _, link := range links {
+				switch {
+				case link.AuthorID == targetID:
+					hasTarget = true
+					merged = append(merged, link)
+				case slices.Contains(sourceIDs, link.AuthorID):
+					if position < 0 || link.Position < position {
+						position = link.Position
+					}
+				default:
+					merged = append(merged, link)
+				}
+			}
+			if !hasTarget {
+				merged = append(merged, bookAuthor{AuthorID: targetID, Position: position})
+			}
+			st.bookAuthors[bookID] = merged
+		}
+		for _, id := range sourceIDs {
+			delete(st.authors, id)
+		}
+
+		for _, before := range befores {
+			after, _ := st.book(before.ID)
+			st.recordRevision(ctx, domain.RevisionUpdate, &before, &after)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return target, nil
+}
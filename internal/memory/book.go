@@ -0,0 +1,425 @@
+package memory
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// bookKey names the book in errors, like in internal/db.
+func bookKey(book domain.Book) string {
+	if book.ISBN != "" {
+		return book.ISBN
+	}
+	return book.Title
+}
+
+// checkUnique follows books_isbn_key and books_title_edition_key: among books
+// outside the trash ISBN is unique, books without ISBN are unique by title and
+// edition.
+func (s *state) checkUnique(book domain.Book, key string) error {
+	if book.DeletedAt != nil {
+		return nil
+	}
+	for _, other := range s.books {
+		if other.ID == book.ID || other.DeletedAt != nil {
+			continue
+		}
+		field := ""
+		switch {
+		case book.ISBN != "" && other.ISBN == book.ISBN:
+			field = "isbn"
+		case book.ISBN == "" && other.ISBN == "" && other.Title == book.Title && other.Edition == book.Edition:
+			field = "title"
+		}
+		if field != "" {
+			e := errs.AlreadyExists("book", key)
+			e.Fields = []errs.FieldViolation{{Field: field, Description: "already taken"}}
+			return e
+		}
+	}
+	return nil
+}
+
+// book returns the book with its authors, trash included.
+func (s *state) book(id int) (domain.Book, bool) {
+	book, ok := s.books[id]
+	if !ok {
+		return domain.Book{}, false
+	}
+	book.Authors = nil
+	links := slices.Clone(s.bookAuthors[id])
+	slices.SortFunc(links, func(a, b bookAuthor) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.AuthorID, b.AuthorID))
+	})
+	for _, link := range links {
+		book.Authors = append(book.Authors, s.authors[link.AuthorID])
+	}
+	return book, true
+}
+
+// liveBook is a book outside the trash, errs.NotFound otherwise.
+func (s *state) liveBook(id int, key any) (domain.Book, error) {
+	book, ok := s.book(id)
+	if !ok || book.DeletedAt != nil {
+		return domain.Book{}, errs.NotFound("book", key)
+	}
+	return book, nil
+}
+
+// lockedBook is what lockBook reads in internal/db.
+func (s *state) lockedBook(id int) (domain.Book, error) {
+	book, ok := s.book(id)
+	if !ok {
+		return domain.Book{}, errs.NotFound("book", id)
+	}
+	return book, nil
+}
+
+func checkBookWrite(book domain.Book, userID, version int) error {
+	if book.DeletedAt != nil {
+		return errs.NotFound("book", book.ID)
+	}
+	if userID != 0 && book.UserID != userID {
+		return errs.PermissionDenied("book", book.ID, "book belongs to another user")
+	}
+	if version != 0 && book.Version != version {
+		return errs.Aborted("book", book.ID, fmt.Sprintf("book was changed: version is %d, expected %d", book.Version, version))
+	}
+	return nil
+}
+
+// putBook stores the book without its authors.
+func (s *state) putBook(book domain.Book) {
+	book.Authors = nil
+	s.books[book.ID] = book
+}
+
+func (s *state) insertBook(book domain.Book) (domain.Book, error) {
+	row := domain.Book{
+		ID:        s.lastBookID + 1,
+		Title:     book.Title,
+		Year:      book.Year,
+		UserID:    book.UserID,
+		ISBN:      book.ISBN,
+		Edition:   book.Edition,
+		Publisher: book.Publisher,
+		CoverURL:  book.CoverURL,
+		Version:   1,
+	}
+	if err := s.checkUnique(row, bookKey(book)); err != nil {
+		return domain.Book{}, err
+	}
+	s.lastBookID++
+	s.putBook(row)
+	return row, nil
+}
+
+func (r *Repository) SaveBookToDatabase(book domain.Book, ctx context.Context) (domain.Book, error) {
+	var saved domain.Book
+	err := r.update(func(st *state) error {
+		var err error
+		saved, err = st.insertBook(book)
+		if err != nil {
+			return err
+		}
+		if len(book.Authors) > 0 {
+			if err := st.setBookAuthors(saved.ID, book.Authors); err != nil {
+				return err
+			}
+			saved, _ = st.book(saved.ID)
+		}
+		st.recordRevision(ctx, domain.RevisionCreate, nil, &saved)
+		return nil
+	})
+	return saved, err
+}
+
+func (r *Repository) GetBookFromDatebase(id uint, ctx context.Context) (domain.Book, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	return st.liveBook(int(id), id)
+}
+
+func (r *Repository) GetBookByIsbn(ctx context.Context, isbn string) (domain.Book, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	for id, book := range st.books {
+		if book.ISBN == isbn && book.DeletedAt == nil {
+			return st.liveBook(id, isbn)
+		}
+	}
+	return domain.Book{}, errs.NotFound("book", isbn)
+}
+
+func (r *Repository) DeleteBookFromDatebase(id uint, version int, ctx context.Context) error {
+	return r.deleteBook(ctx, int(id), version, 0)
+}
+
+func (r *Repository) DeleteBookOwnedBy(ctx context.Context, id uint, version int, userID int) error {
+	return r.deleteBook(ctx, int(id), version, userID)
+}
+
+func (r *Repository) deleteBook(ctx context.Context, id, version, userID int) error {
+	return r.update(func(st *state) error {
+		before, err := st.lockedBook(id)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, version); err != nil {
+			return err
+		}
+		deleted := before
+		now := time.Now()
+		deleted.DeletedAt = &now
+		deleted.Version++
+		st.putBook(deleted)
+		st.recordRevision(ctx, domain.RevisionDelete, &before, &deleted)
+		return nil
+	})
+}
+
+func (r *Repository) UpdateBookFromDatabase(book domain.Book, fields []string, ctx context.Context) (domain.Book, error) {
+	return r.updateBook(ctx, book, fields, 0)
+}
+
+func (r *Repository) UpdateBookOwnedBy(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	return r.updateBook(ctx, book, fields, userID)
+}
+
+func (r *Repository) updateBook(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	var updated domain.Book
+	err := r.update(func(st *state) error {
+		before, err := st.lockedBook(book.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, book.Version); err != nil {
+			return err
+		}
+		updated, err = st.updateBookFields(before, book, fields)
+		if err != nil {
+			return err
+		}
+		st.recordRevision(ctx, domain.RevisionUpdate, &before, &updated)
+		return nil
+	})
+	return updated, err
+}
+
+// updateBookFields copies the fields from book to the stored one and bumps
+// the version.
+func (s *state) updateBookFields(stored, book domain.Book, fields []string) (domain.Book, error) {
+	updated := stored
+	authors := false
+	for _, field := range fields {
+		switch field {
+		case "title":
+			updated.Title = book.Title
+		case "year":
+			updated.Year = book.Year
+		case "isbn":
+			updated.ISBN = book.ISBN
+		case "edition":
+			updated.Edition = book.Edition
+		case "publisher":
+			updated.Publisher = book.Publisher
+		case "cover_url":
+			updated.CoverURL = book.CoverURL
+		case domain.BookAuthorsField:
+			authors = true
+		default:
+			return domain.Book{}, errs.InvalidArgument("invalid update_mask",
+				errs.FieldViolation{Field: "update_mask", Description: "unknown field " + field})
+		}
+	}
+	updated.Version++
+	if err := s.checkUnique(updated, bookKey(book)); err != nil {
+		return domain.Book{}, err
+	}
+	s.putBook(updated)
+	if authors {
+		if err := s.setBookAuthors(updated.ID, book.Authors); err != nil {
+			return domain.Book{}, err
+		}
+		updated, _ = s.book(updated.ID)
+	}
+	return updated, nil
+}
+
+var bookOrders = []string{"", "id", "title", "year"}
+
+func (r *Repository) AllBooksFromDatabase(ctx context.Context, query domain.BookQuery) ([]domain.Book, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	return st.listBooks(query)
+}
+
+func (r *Repository) StreamBooks(ctx context.Context, query domain.BookQuery, fn func(domain.Book) error) error {
+	books, err := r.AllBooksFromDatabase(ctx, query)
+	if err != nil {
+		return err
+	}
+	for _, book := range books {
+		if err := fn(book); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Repository) ExportBooks(ctx context.Context, filter domain.BookFilter, fn func(domain.Book) error) error {
+	return r.StreamBooks(ctx, domain.BookQuery{Filter: filter}, fn)
+}
+
+func (r *Repository) CountBooks(ctx context.Context, filter domain.BookFilter) (int, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	return len(st.filterBooks(filter)), nil
+}
+
+func (s *state) filterBooks(filter domain.BookFilter) []domain.Book {
+	var books []domain.Book
+	for id, book := range s.books {
+		switch {
+		case (book.DeletedAt != nil) != filter.Deleted:
+		case filter.TitleContains != "" && !strings.Contains(strings.ToLower(book.Title), strings.ToLower(filter.TitleContains)):
+		case filter.YearMin != nil && book.Year < *filter.YearMin:
+		case filter.YearMax != nil && book.Year > *filter.YearMax:
+		case filter.OwnerID != 0 && book.UserID != filter.OwnerID:
+		default:
+			book, _ = s.book(id)
+			books = append(books, book)
+		}
+	}
+	return books
+}
+
+// listBooks orders and pages the books the way bookListQuery in internal/db does.
+func (s *state) listBooks(query domain.BookQuery) ([]domain.Book, error) {
+	if !slices.Contains(bookOrders, query.OrderBy) {
+		return nil, errs.InvalidArgument("invalid order_by", errs.FieldViolation{Field: "order_by", Description: "unknown field " + query.OrderBy})
+	}
+	compare := func(a, b domain.Book) int {
+		var c int
+		switch query.OrderBy {
+		case "title":
+			c = strings.Compare(a.Title, b.Title)
+		case "year":
+			c = cmp.Compare(a.Year, b.Year)
+		}
+		c = cmp.Or(c, cmp.Compare(a.ID, b.ID))
+		if query.Desc {
+			return -c
+		}
+		return c
+	}
+
+	books := s.filterBooks(query.Filter)
+	if query.After != nil {
+		books = slices.DeleteFunc(books, func(book domain.Book) bool {
+			return compare(book, *query.After) <= 0
+		})
+	}
+	slices.SortFunc(books, compare)
+	if query.Limit > 0 && len(books) > query.Limit {
+		books = books[:query.Limit]
+	}
+	return books, nil
+}
+
+// SearchBooks only approximates the Postgres search: a book is found when its
+// title contains one of the words, the rank is the share of matched words.
+func (r *Repository) SearchBooks(ctx context.Context, text string, limit int) ([]domain.BookSearchResult, error) {
+	st, unlock := r.lock()
+	defer unlock()
+
+	words := strings.Fields(strings.ToLower(text))
+	var results []domain.BookSearchResult
+	for _, book := range st.filterBooks(domain.BookFilter{}) {
+		titleWords := strings.Fields(book.Title)
+		matched := 0
+		for i, word := range titleWords {
+			if slices.Contains(words, strings.ToLower(word)) {
+				titleWords[i] = domain.SnippetStart + word + domain.SnippetStop
+				matched++
+			}
+		}
+		if matched == 0 {
+			continue
+		}
+		results = append(results, domain.BookSearchResult{
+			Book:    book,
+			Rank:    float64(matched) / float64(len(words)),
+			Snippet: strings.Join(titleWords, " "),
+		})
+	}
+	slices.SortFunc(results, func(a, b domain.BookSearchResult) int {
+		return cmp.Or(cmp.Compare(b.Rank, a.Rank), cmp.Compare(a.Book.ID, b.Book.ID))
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// ImportBooks checks every book before changing anything, so a failed book
+// leaves no trace, like the savepoint in internal/db.
+func (r *Repository) ImportBooks(ctx context.Context, books []domain.Book, dryRun bool) ([]domain.ImportResult, error) {
+	results := make([]domain.ImportResult, len(books))
+	err := r.update(func(st *state) error {
+		for i, book := range books {
+			results[i].ID, results[i].Err = st.importBook(ctx, book)
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err == errDryRun {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+var errDryRun = fmt.Errorf("dry run")
+
+func (s *state) importBook(ctx context.Context, book domain.Book) (int, error) {
+	for _, author := range book.Authors {
+		if _, ok := s.authors[author.ID]; author.ID != 0 && !ok {
+			return 0, errs.NotFound("author", author.ID)
+		}
+	}
+	row := book
+	row.ID, row.DeletedAt = 0, nil
+	if s.checkUnique(row, bookKey(book)) != nil {
+		return 0, nil
+	}
+
+	inserted, err := s.insertBook(book)
+	if err != nil {
+		return 0, err
+	}
+	var links []bookAuthor
+	for position, author := range book.Authors {
+		if author.ID == 0 {
+			author = s.ensureAuthor(author.Name)
+		}
+		if !slices.ContainsFunc(links, func(link bookAuthor) bool { return link.AuthorID == author.ID }) {
+			links = append(links, bookAuthor{AuthorID: author.ID, Position: position})
+		}
+	}
+	s.bookAuthors[inserted.ID] = links
+
+	imported, _ := s.book(inserted.ID)
+	s.recordRevision(ctx, domain.RevisionCreate, nil, &imported)
+	return inserted.ID, nil
+}
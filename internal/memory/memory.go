@@ -0,0 +1,106 @@
+// Package memory is an api.Repository that keeps everything in maps. It follows
+// the Postgres schema closely enough for tests and demos: IDs are assigned in
+// order and the unique constraints are checked the same way.
+package memory
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+type Repository struct {
+	mu *sync.Mutex
+	// st указывает на общее состояние; внутри WithTx — на его копию.
+	st         **state
+	inTx       bool
+	sessionTTL domain.SessionTTL
+}
+
+// bookAuthor is a row of book_authors.
+type bookAuthor struct {
+	AuthorID int
+	Position int
+}
+
+type state struct {
+	// Книги хранятся без авторов, авторы — в bookAuthors.
+	books       map[int]domain.Book
+	bookAuthors map[int][]bookAuthor
+	authors     map[int]domain.Author
+	users       map[int]domain.User
+	sessions    map[int]domain.Session
+	revisions   []domain.BookRevision
+
+	lastBookID, lastAuthorID, lastUserID, lastSessionID, lastRevisionID int
+}
+
+func NewRepository(sessionTTL domain.SessionTTL) *Repository {
+	st := &state{
+		books:       map[int]domain.Book{},
+		bookAuthors: map[int][]bookAuthor{},
+		authors:     map[int]domain.Author{},
+		users:       map[int]domain.User{},
+		sessions:    map[int]domain.Session{},
+	}
+	return &Repository{mu: &sync.Mutex{}, st: &st, sessionTTL: sessionTTL}
+}
+
+// clone copies the state so that changes to the copy do not show in the
+// original. Slices in the maps are never changed in place, only replaced.
+func (s *state) clone() *state {
+	c := *s
+	c.books = maps.Clone(s.books)
+	c.bookAuthors = maps.Clone(s.bookAuthors)
+	c.authors = maps.Clone(s.authors)
+	c.users = maps.Clone(s.users)
+	c.sessions = maps.Clone(s.sessions)
+	c.revisions = slices.Clip(s.revisions)
+	return &c
+}
+
+// lock locks the repository and returns its state. Inside WithTx the lock is
+// already held.
+func (r *Repository) lock() (*state, func()) {
+	if r.inTx {
+		return *r.st, func() {}
+	}
+	r.mu.Lock()
+	return *r.st, r.mu.Unlock
+}
+
+// WithTx runs fn on a copy of the state and keeps the copy only if fn
+// succeeds. The repository stays locked while fn runs, so there is nothing
+// to retry.
+func (r *Repository) WithTx(ctx context.Context, fn func(api.Repository) error) error {
+	if r.inTx {
+		return fn(r)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	st := (*r.st).clone()
+	tx := &Repository{mu: r.mu, st: &st, inTx: true, sessionTTL: r.sessionTTL}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	*r.st = st
+	return nil
+}
+
+// update runs fn on a copy of the state and keeps it only if fn succeeds,
+// like a statement that is rolled back as a whole.
+func (r *Repository) update(fn func(st *state) error) error {
+	st, unlock := r.lock()
+	defer unlock()
+
+	c := st.clone()
+	if err := fn(c); err != nil {
+		return err
+	}
+	*r.st = c
+	return nil
+}
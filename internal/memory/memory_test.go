@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/memory"
+	"bookserver_git/internal/repotest"
+	"testing"
+)
+
+func TestRepository(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) api.Repository {
+		return memory.NewRepository(domain.SessionTTL{})
+	})
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"bookserver_git/internal/principal"
+	"context"
+	"slices"
+	"time"
+)
+
+func (s *state) recordRevision(ctx context.Context, action string, before, after *domain.Book) {
+	revision := domain.BookRevision{
+		Action:    action,
+		ChangedAt: time.Now(),
+		Before:    cloneBook(before),
+		After:     cloneBook(after),
+	}
+	if caller, ok := principal.FromContext(ctx); ok {
+		revision.ActorID = caller.UserID
+	}
+	book := after
+	if book == nil {
+		book = before
+	}
+	s.lastRevisionID++
+	revision.ID, revision.BookID, revision.Version = s.lastRevisionID, book.ID, book.Version
+	s.revisions = append(s.revisions, revision)
+}
+
+// cloneBook copies the book for a snapshot that later changes must not touch.
+func cloneBook(book *domain.Book) *domain.Book {
+	if book == nil {
+		return nil
+	}
+	c := *book
+	c.Authors = slices.Clone(book.Authors)
+	return &c
+}
+
+func (r *Repository) ListBookRevisions(ctx context.Context, bookID int, userID int, afterID int, limit int) ([]domain.BookRevision, error) {
+	st, unlock := r.lock()
+	defer unlock()
+
+	book, ok := st.books[bookID]
+	if !ok {
+		return nil, errs.NotFound("book", bookID)
+	}
+	if userID != 0 && book.UserID != userID {
+		return nil, errs.PermissionDenied("book", bookID, "book belongs to another user")
+	}
+
+	var revisions []domain.BookRevision
+	for _, revision := range st.revisions {
+		if len(revisions) == limit {
+			break
+		}
+		if revision.BookID == bookID && revision.ID > afterID {
+			revision.Before, revision.After = cloneBook(revision.Before), cloneBook(revision.After)
+			revisions = append(revisions, revision)
+		}
+	}
+	return revisions, nil
+}
+
+func (r *Repository) RevertBookToRevision(ctx context.Context, bookID int, revisionID int, version int) (domain.Book, error) {
+	return r.revertBook(ctx, bookID, revisionID, version, 0)
+}
+
+func (r *Repository) RevertBookToRevisionOwnedBy(ctx context.Context, bookID int, revisionID int, version int, userID int) (domain.Book, error) {
+	return r.revertBook(ctx, bookID, revisionID, version, userID)
+}
+
+func (r *Repository) revertBook(ctx context.Context, bookID, revisionID, version, userID int) (domain.Book, error) {
+	var after domain.Book
+	err := r.update(func(st *state) error {
+		before, err := st.lockedBook(bookID)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, version); err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(st.revisions, func(revision domain.BookRevision) bool {
+			return revision.ID == revisionID && revision.BookID == bookID
+		})
+		if i < 0 {
+			return errs.NotFound("revision", revisionID)
+		}
+		target := st.revisions[i].After
+		if target == nil {
+			return errs.InvalidArgument("invalid revision_id",
+				errs.FieldViolation{Field: "revision_id", Description: "revision has no book state"})
+		}
+
+		if _, err := st.updateBookFields(before, *target, domain.BookFields); err != nil {
+			return err
+		}
+		// Авторов, удалённых с тех пор, пропускаем.
+		var links []bookAuthor
+		for position, author := range target.Authors {
+			if _, ok := st.authors[author.ID]; ok {
+				links = append(links, bookAuthor{AuthorID: author.ID, Position: position})
+			}
+		}
+		st.bookAuthors[bookID] = links
+
+		after, _ = st.book(bookID)
+		st.recordRevision(ctx, domain.RevisionRevert, &before, &after)
+		return nil
+	})
+	return after, err
+}
@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"time"
+)
+
+func (r *Repository) UndeleteBook(ctx context.Context, id int) (domain.Book, error) {
+	return r.undeleteBook(ctx, id, 0)
+}
+
+func (r *Repository) UndeleteBookOwnedBy(ctx context.Context, id int, userID int) (domain.Book, error) {
+	return r.undeleteBook(ctx, id, userID)
+}
+
+func (r *Repository) undeleteBook(ctx context.Context, id, userID int) (domain.Book, error) {
+	var book domain.Book
+	err := r.update(func(st *state) error {
+		before, err := st.lockedBook(id)
+		if err != nil {
+			return err
+		}
+		if before.DeletedAt == nil {
+			return errs.NotFound("book", id)
+		}
+		if userID != 0 && before.UserID != userID {
+			return errs.PermissionDenied("book", id, "book belongs to another user")
+		}
+
+		book = before
+		book.DeletedAt = nil
+		book.Version++
+		if err := st.checkUnique(book, bookKey(before)); err != nil {
+			return err
+		}
+		st.putBook(book)
+		st.recordRevision(ctx, domain.RevisionUndelete, &before, &book)
+		return nil
+	})
+	return book, err
+}
+
+func (r *Repository) PurgeDeletedBooks(ctx context.Context, retention time.Duration) (int64, error) {
+	var purged int64
+	err := r.update(func(st *state) error {
+		deadline := time.Now().Add(-retention)
+		for id, book := range st.books {
+			if book.DeletedAt != nil && book.DeletedAt.Before(deadline) {
+				delete(st.books, id)
+				delete(st.bookAuthors, id)
+				purged++
+			}
+		}
+		return nil
+	})
+	return purged, err
+}
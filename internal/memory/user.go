@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+func (r *Repository) SaveUserToDatabase(ctx context.Context, user domain.User) (domain.User, error) {
+	var saved domain.User
+	err := r.update(func(st *state) error {
+		for _, other := range st.users {
+			if other.Email == user.Email {
+				e := errs.AlreadyExists("user", user.Email)
+				e.Fields = []errs.FieldViolation{{Field: "email", Description: "already taken"}}
+				return e
+			}
+		}
+		st.lastUserID++
+		saved = domain.User{ID: st.lastUserID, Email: user.Email, Password: user.Password}
+		st.users[saved.ID] = saved
+		return nil
+	})
+	return saved, err
+}
+
+func (r *Repository) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
+	st, unlock := r.lock()
+	defer unlock()
+	for _, user := range st.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return domain.User{}, errs.NotFound("user", email)
+}
+
+func (r *Repository) UpdateUserPassword(ctx context.Context, userID int, password string) error {
+	return r.update(func(st *state) error {
+		if user, ok := st.users[userID]; ok {
+			user.Password = password
+			st.users[userID] = user
+		}
+		return nil
+	})
+}
+
+func (r *Repository) SaveSessionToDatabase(ctx context.Context, session domain.Session) error {
+	return r.update(func(st *state) error {
+		st.lastSessionID++
+		now := time.Now()
+		session.ID, session.CreatedAt, session.LastSeenAt = st.lastSessionID, now, now
+		st.sessions[session.ID] = session
+		return nil
+	})
+}
+
+// alive is sessionAlive of internal/db: zero TTLs do not limit the session.
+func (r *Repository) alive(session domain.Session, now time.Time) bool {
+	if r.sessionTTL.Absolute != 0 && !session.CreatedAt.After(now.Add(-r.sessionTTL.Absolute)) {
+		return false
+	}
+	if r.sessionTTL.Idle != 0 && !session.LastSeenAt.After(now.Add(-r.sessionTTL.Idle)) {
+		return false
+	}
+	return true
+}
+
+func (r *Repository) GetUserByToken(ctx context.Context, token string) (domain.User, error) {
+	var user domain.User
+	err := r.update(func(st *state) error {
+		now := time.Now()
+		for id, session := range st.sessions {
+			if session.Token != token || !r.alive(session, now) {
+				continue
+			}
+			session.LastSeenAt = now
+			st.sessions[id] = session
+			user = st.users[session.UserID]
+			return nil
+		}
+		return errs.NotFound("session", "")
+	})
+	return user, err
+}
+
+func (r *Repository) ListUserSessions(ctx context.Context, userID int) ([]domain.Session, error) {
+	st, unlock := r.lock()
+	defer unlock()
+
+	now := time.Now()
+	var sessions []domain.Session
+	for _, session := range st.sessions {
+		if session.UserID == userID && r.alive(session, now) {
+			sessions = append(sessions, session)
+		}
+	}
+	slices.SortFunc(sessions, func(a, b domain.Session) int {
+		return cmp.Or(b.LastSeenAt.Compare(a.LastSeenAt), cmp.Compare(a.ID, b.ID))
+	})
+	return sessions, nil
+}
+
+func (r *Repository) DeleteSessionByToken(ctx context.Context, token string) error {
+	_, err := r.deleteSessions(func(session domain.Session) bool { return session.Token == token })
+	return err
+}
+
+func (r *Repository) DeleteUserSession(ctx context.Context, userID int, sessionID int) error {
+	deleted, err := r.deleteSessions(func(session domain.Session) bool {
+		return session.ID == sessionID && session.UserID == userID
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.NotFound("session", sessionID)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteUserSessions(ctx context.Context, userID int, exceptToken string) (int64, error) {
+	return r.deleteSessions(func(session domain.Session) bool {
+		return session.UserID == userID && session.Token != exceptToken
+	})
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	now := time.Now()
+	return r.deleteSessions(func(session domain.Session) bool { return !r.alive(session, now) })
+}
+
+func (r *Repository) deleteSessions(match func(domain.Session) bool) (int64, error) {
+	var deleted int64
+	err := r.update(func(st *state) error {
+		for id, session := range st.sessions {
+			if match(session) {
+				delete(st.sessions, id)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,65 @@
+package metadata
+
+import (
+	"sync"
+	"time"
+)
+
+const maxCacheEntries = 10000
+
+type cacheEntry struct {
+	metadata Metadata
+	sources  []string
+	expires  time.Time
+}
+
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+// newCache returns a cache that keeps entries for ttl; zero ttl disables it.
+func newCache(ttl time.Duration) *cache {
+	return &cache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+func (c *cache) get(key string) (cacheEntry, bool) {
+	if c.ttl <= 0 {
+		return cacheEntry{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *cache) put(key string, entry cacheEntry) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxCacheEntries {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCacheEntries {
+			c.entries = make(map[string]cacheEntry)
+		}
+	}
+	entry.expires = now.Add(c.ttl)
+	c.entries[key] = entry
+}
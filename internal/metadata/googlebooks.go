@@ -0,0 +1,69 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const googleBooksURL = "https://www.googleapis.com"
+
+// GoogleBooks uses the Volumes API: GET /books/v1/volumes?q=isbn:<isbn>
+type GoogleBooks struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func NewGoogleBooks(baseURL, apiKey string, client *http.Client) *GoogleBooks {
+	if baseURL == "" {
+		baseURL = googleBooksURL
+	}
+	return &GoogleBooks{baseURL: strings.TrimRight(baseURL, "/"), apiKey: apiKey, client: client}
+}
+
+func (g *GoogleBooks) Name() string {
+	return ProviderGoogleBooks
+}
+
+type googleBooksResponse struct {
+	TotalItems int `json:"totalItems"`
+	Items      []struct {
+		VolumeInfo struct {
+			Title         string   `json:"title"`
+			Authors       []string `json:"authors"`
+			Publisher     string   `json:"publisher"`
+			PublishedDate string   `json:"publishedDate"`
+			ImageLinks    struct {
+				SmallThumbnail string `json:"smallThumbnail"`
+				Thumbnail      string `json:"thumbnail"`
+			} `json:"imageLinks"`
+		} `json:"volumeInfo"`
+	} `json:"items"`
+}
+
+func (g *GoogleBooks) LookupISBN(ctx context.Context, isbn string) (Metadata, error) {
+	query := url.Values{"q": {"isbn:" + isbn}}
+	if g.apiKey != "" {
+		query.Set("key", g.apiKey)
+	}
+	var response googleBooksResponse
+	err := getJSON(ctx, g.client, g.baseURL+"/books/v1/volumes?"+query.Encode(), &response)
+	if err != nil {
+		return Metadata{}, err
+	}
+	if len(response.Items) == 0 {
+		return Metadata{}, ErrNotFound
+	}
+
+	info := response.Items[0].VolumeInfo
+	return Metadata{
+		ISBN:      isbn,
+		Title:     info.Title,
+		Authors:   info.Authors,
+		Year:      parseYear(info.PublishedDate),
+		Publisher: info.Publisher,
+		CoverURL:  firstNonEmpty(info.ImageLinks.Thumbnail, info.ImageLinks.SmallThumbnail),
+	}, nil
+}
@@ -0,0 +1,168 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Metadata is what a provider knows about a book.
+type Metadata struct {
+	ISBN      string
+	Title     string
+	Authors   []string
+	Year      int
+	Publisher string
+	CoverURL  string
+}
+
+func (m Metadata) complete() bool {
+	return m.Title != "" && len(m.Authors) > 0 && m.Year != 0 && m.Publisher != "" && m.CoverURL != ""
+}
+
+// merge fills empty fields of m from other.
+func (m Metadata) merge(other Metadata) Metadata {
+	if m.Title == "" {
+		m.Title = other.Title
+	}
+	if len(m.Authors) == 0 {
+		m.Authors = other.Authors
+	}
+	if m.Year == 0 {
+		m.Year = other.Year
+	}
+	if m.Publisher == "" {
+		m.Publisher = other.Publisher
+	}
+	if m.CoverURL == "" {
+		m.CoverURL = other.CoverURL
+	}
+	return m
+}
+
+var (
+	ErrNotFound    = errors.New("book metadata not found")
+	ErrUnavailable = errors.New("metadata providers unavailable")
+)
+
+// Provider looks a book up by its ISBN-13 in some external catalog.
+// It returns ErrNotFound when the catalog does not know the book.
+type Provider interface {
+	Name() string
+	LookupISBN(ctx context.Context, isbn string) (Metadata, error)
+}
+
+const (
+	ProviderOpenLibrary = "openlibrary"
+	ProviderGoogleBooks = "googlebooks"
+)
+
+type Config struct {
+	CacheTTL  time.Duration    `yaml:"cache_ttl"`
+	Providers []ProviderConfig `yaml:"providers"`
+}
+
+type ProviderConfig struct {
+	Name string `yaml:"name"`
+	// BaseURL позволяет подставить локальную заглушку вместо настоящего API.
+	BaseURL string        `yaml:"base_url"`
+	Timeout time.Duration `yaml:"timeout"`
+	APIKey  string        `yaml:"api_key"`
+}
+
+const defaultTimeout = 5 * time.Second
+
+// New builds an Enricher from the config, nil if no providers are configured.
+func New(cfg Config) (*Enricher, error) {
+	if len(cfg.Providers) == 0 {
+		return nil, nil
+	}
+
+	enricher := &Enricher{cache: newCache(cfg.CacheTTL)}
+	for _, p := range cfg.Providers {
+		timeout := p.Timeout
+		if timeout <= 0 {
+			timeout = defaultTimeout
+		}
+		client := &http.Client{Timeout: timeout}
+
+		var provider Provider
+		switch p.Name {
+		case ProviderOpenLibrary:
+			provider = NewOpenLibrary(p.BaseURL, client)
+		case ProviderGoogleBooks:
+			provider = NewGoogleBooks(p.BaseURL, p.APIKey, client)
+		default:
+			return nil, fmt.Errorf("unknown metadata provider %q", p.Name)
+		}
+		enricher.providers = append(enricher.providers, timedProvider{Provider: provider, timeout: timeout})
+	}
+	return enricher, nil
+}
+
+type timedProvider struct {
+	Provider
+	timeout time.Duration
+}
+
+// Enricher asks the providers in order until every field is known and caches
+// the merged result.
+type Enricher struct {
+	providers []timedProvider
+	cache     *cache
+}
+
+// Lookup returns the merged metadata and the names of the providers that
+// contributed to it.
+func (e *Enricher) Lookup(ctx context.Context, isbn string) (Metadata, []string, error) {
+	if entry, ok := e.cache.get(isbn); ok {
+		return entry.metadata, entry.sources, nil
+	}
+
+	result := Metadata{ISBN: isbn}
+	var sources []string
+	var failed []error
+	for _, p := range e.providers {
+		providerCtx, cancel := context.WithTimeout(ctx, p.timeout)
+		found, err := p.LookupISBN(providerCtx, isbn)
+		cancel()
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			failed = append(failed, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+
+		result = result.merge(found)
+		sources = append(sources, p.Name())
+		if result.complete() {
+			break
+		}
+	}
+
+	if len(sources) == 0 {
+		if len(failed) > 0 {
+			return Metadata{}, nil, fmt.Errorf("%w: %w", ErrUnavailable, errors.Join(failed...))
+		}
+		return Metadata{}, nil, ErrNotFound
+	}
+	e.cache.put(isbn, cacheEntry{metadata: result, sources: sources})
+	return result, sources, nil
+}
+
+var yearPattern = regexp.MustCompile(`\b(\d{4})\b`)
+
+// parseYear finds the year in dates like "2004", "May 2004" or "2004-05-01".
+func parseYear(date string) int {
+	match := yearPattern.FindStringSubmatch(date)
+	if match == nil {
+		return 0
+	}
+	year, _ := strconv.Atoi(match[1])
+	return year
+}
@@ -0,0 +1,283 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const isbn = "9780441013593"
+
+// catalog serves body with status for every request and counts the requests.
+func catalog(t *testing.T, status int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+const openLibraryDune = `{"ISBN:9780441013593": {
+	"title": "Dune",
+	"publish_date": "August 1990",
+	"authors": [{"name": "Frank Herbert"}],
+	"publishers": [{"name": "Ace Books"}, {"name": "Chilton"}],
+	"cover": {"small": "https://covers.example/s.jpg", "large": "https://covers.example/l.jpg"}
+}}`
+
+const googleBooksDune = `{"totalItems": 1, "items": [{"volumeInfo": {
+	"title": "Dune (Deluxe Edition)",
+	"authors": ["Frank Herbert"],
+	"publisher": "Penguin",
+	"publishedDate": "2019-10-01",
+	"imageLinks": {"smallThumbnail": "https://books.example/s.jpg", "thumbnail": "https://books.example/t.jpg"}
+}}]}`
+
+func TestOpenLibrary(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Path + "?" + r.URL.RawQuery
+		w.Write([]byte(openLibraryDune))
+	}))
+	defer server.Close()
+
+	got, err := NewOpenLibrary(server.URL, server.Client()).LookupISBN(context.Background(), isbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/api/books?bibkeys=ISBN%3A9780441013593&format=json&jscmd=data"; query != want {
+		t.Errorf("query %s, want %s", query, want)
+	}
+	want := Metadata{
+		ISBN:      isbn,
+		Title:     "Dune",
+		Authors:   []string{"Frank Herbert"},
+		Year:      1990,
+		Publisher: "Ace Books",
+		CoverURL:  "https://covers.example/l.jpg",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestOpenLibraryNotFound(t *testing.T) {
+	server, _ := catalog(t, http.StatusOK, `{}`)
+	_, err := NewOpenLibrary(server.URL, server.Client()).LookupISBN(context.Background(), isbn)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("err %v, want ErrNotFound", err)
+	}
+}
+
+func TestGoogleBooks(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Path + "?" + r.URL.RawQuery
+		w.Write([]byte(googleBooksDune))
+	}))
+	defer server.Close()
+
+	got, err := NewGoogleBooks(server.URL, "secret", server.Client()).LookupISBN(context.Background(), isbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/books/v1/volumes?key=secret&q=isbn%3A9780441013593"; query != want {
+		t.Errorf("query %s, want %s", query, want)
+	}
+	want := Metadata{
+		ISBN:      isbn,
+		Title:     "Dune (Deluxe Edition)",
+		Authors:   []string{"Frank Herbert"},
+		Year:      2019,
+		Publisher: "Penguin",
+		CoverURL:  "https://books.example/t.jpg",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestGoogleBooksNotFound(t *testing.T) {
+	server, _ := catalog(t, http.StatusOK, `{"totalItems": 0}`)
+	_, err := NewGoogleBooks(server.URL, "", server.Client()).LookupISBN(context.Background(), isbn)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("err %v, want ErrNotFound", err)
+	}
+}
+
+func TestParseYear(t *testing.T) {
+	for date, want := range map[string]int{
+		"2004":       2004,
+		"May 2004":   2004,
+		"2004-05-01": 2004,
+		"c. 1850?":   1850,
+		"":           0,
+		"unknown":    0,
+	} {
+		if got := parseYear(date); got != want {
+			t.Errorf("parseYear(%q) = %d, want %d", date, got, want)
+		}
+	}
+}
+
+func TestLookupMergesInOrder(t *testing.T) {
+	// У Open Library нет обложки, её берём из Google Books.
+	openLibrary, _ := catalog(t, http.StatusOK, `{"ISBN:9780441013593": {
+		"title": "Dune", "publish_date": "1990", "authors": [{"name": "Frank Herbert"}]
+	}}`)
+	googleBooks, _ := catalog(t, http.StatusOK, googleBooksDune)
+	enricher, err := New(Config{Providers: []ProviderConfig{
+		{Name: ProviderOpenLibrary, BaseURL: openLibrary.URL},
+		{Name: ProviderGoogleBooks, BaseURL: googleBooks.URL},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, sources, err := enricher.Lookup(context.Background(), isbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Metadata{
+		ISBN:      isbn,
+		Title:     "Dune",
+		Authors:   []string{"Frank Herbert"},
+		Year:      1990,
+		Publisher: "Penguin",
+		CoverURL:  "https://books.example/t.jpg",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if want := []string{ProviderOpenLibrary, ProviderGoogleBooks}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("sources %v, want %v", sources, want)
+	}
+}
+
+func TestLookupStopsWhenComplete(t *testing.T) {
+	openLibrary, _ := catalog(t, http.StatusOK, openLibraryDune)
+	googleBooks, googleRequests := catalog(t, http.StatusOK, googleBooksDune)
+	enricher, err := New(Config{Providers: []ProviderConfig{
+		{Name: ProviderOpenLibrary, BaseURL: openLibrary.URL},
+		{Name: ProviderGoogleBooks, BaseURL: googleBooks.URL},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, sources, err := enricher.Lookup(context.Background(), isbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{ProviderOpenLibrary}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("sources %v, want %v", sources, want)
+	}
+	if n := googleRequests.Load(); n != 0 {
+		t.Errorf("%d requests to the second provider, want 0", n)
+	}
+}
+
+func TestLookupProviderTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slow.Close()
+	googleBooks, _ := catalog(t, http.StatusOK, googleBooksDune)
+	enricher, err := New(Config{Providers: []ProviderConfig{
+		{Name: ProviderOpenLibrary, BaseURL: slow.URL, Timeout: 50 * time.Millisecond},
+		{Name: ProviderGoogleBooks, BaseURL: googleBooks.URL},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	got, sources, err := enricher.Lookup(context.Background(), isbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("lookup took %v, the slow provider was not cut off", elapsed)
+	}
+	if want := []string{ProviderGoogleBooks}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("sources %v, want %v", sources, want)
+	}
+	if got.Publisher != "Penguin" {
+		t.Errorf("publisher %q, want Penguin", got.Publisher)
+	}
+}
+
+func TestLookupUnavailable(t *testing.T) {
+	broken, _ := catalog(t, http.StatusInternalServerError, "")
+	enricher, err := New(Config{Providers: []ProviderConfig{{Name: ProviderGoogleBooks, BaseURL: broken.URL}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = enricher.Lookup(context.Background(), isbn)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("err %v, want ErrUnavailable", err)
+	}
+}
+
+func TestLookupCache(t *testing.T) {
+	server, requests := catalog(t, http.StatusOK, openLibraryDune)
+	enricher, err := New(Config{
+		CacheTTL:  time.Hour,
+		Providers: []ProviderConfig{{Name: ProviderOpenLibrary, BaseURL: server.URL}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lookup := func() {
+		t.Helper()
+		if _, _, err := enricher.Lookup(context.Background(), isbn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lookup()
+	lookup()
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("%d requests, want 1: the second lookup is a cache hit", n)
+	}
+
+	// Запись протухла.
+	enricher.cache.mu.Lock()
+	entry := enricher.cache.entries[isbn]
+	entry.expires = time.Now().Add(-time.Second)
+	enricher.cache.entries[isbn] = entry
+	enricher.cache.mu.Unlock()
+
+	lookup()
+	if n := requests.Load(); n != 2 {
+		t.Errorf("%d requests, want 2: an expired entry is looked up again", n)
+	}
+}
+
+func TestLookupNoCache(t *testing.T) {
+	server, requests := catalog(t, http.StatusOK, openLibraryDune)
+	enricher, err := New(Config{Providers: []ProviderConfig{{Name: ProviderOpenLibrary, BaseURL: server.URL}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, _, err := enricher.Lookup(context.Background(), isbn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("%d requests, want 2 with cache_ttl 0", n)
+	}
+}
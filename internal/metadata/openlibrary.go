@@ -0,0 +1,111 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const openLibraryURL = "https://openlibrary.org"
+
+// OpenLibrary uses the Books API: GET /api/books?bibkeys=ISBN:<isbn>&format=json&jscmd=data
+type OpenLibrary struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewOpenLibrary(baseURL string, client *http.Client) *OpenLibrary {
+	if baseURL == "" {
+		baseURL = openLibraryURL
+	}
+	return &OpenLibrary{baseURL: strings.TrimRight(baseURL, "/"), client: client}
+}
+
+func (o *OpenLibrary) Name() string {
+	return ProviderOpenLibrary
+}
+
+type openLibraryBook struct {
+	Title       string `json:"title"`
+	PublishDate string `json:"publish_date"`
+	Authors     []struct {
+		Name string `json:"name"`
+	} `json:"authors"`
+	Publishers []struct {
+		Name string `json:"name"`
+	} `json:"publishers"`
+	Cover struct {
+		Small  string `json:"small"`
+		Medium string `json:"medium"`
+		Large  string `json:"large"`
+	} `json:"cover"`
+}
+
+func (o *OpenLibrary) LookupISBN(ctx context.Context, isbn string) (Metadata, error) {
+	bibkey := "ISBN:" + isbn
+	query := url.Values{
+		"bibkeys": {bibkey},
+		"format":  {"json"},
+		"jscmd":   {"data"},
+	}
+	var response map[string]openLibraryBook
+	err := getJSON(ctx, o.client, o.baseURL+"/api/books?"+query.Encode(), &response)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	book, ok := response[bibkey]
+	if !ok {
+		return Metadata{}, ErrNotFound
+	}
+	result := Metadata{
+		ISBN:     isbn,
+		Title:    book.Title,
+		Year:     parseYear(book.PublishDate),
+		CoverURL: firstNonEmpty(book.Cover.Large, book.Cover.Medium, book.Cover.Small),
+	}
+	for _, author := range book.Authors {
+		result.Authors = append(result.Authors, author.Name)
+	}
+	if len(book.Publishers) > 0 {
+		result.Publisher = book.Publishers[0].Name
+	}
+	return result, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("json.Decode: %w", err)
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
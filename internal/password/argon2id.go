@@ -0,0 +1,119 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+type Argon2Params struct {
+	Memory      uint32 `yaml:"memory"` // KiB
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+const argon2idPrefix = "$argon2id$"
+
+type argon2idScheme struct {
+	params Argon2Params
+}
+
+func newArgon2id(p Argon2Params) argon2idScheme {
+	if p.Memory == 0 {
+		p.Memory = 64 * 1024
+	}
+	if p.Iterations == 0 {
+		p.Iterations = 3
+	}
+	if p.Parallelism == 0 {
+		p.Parallelism = 2
+	}
+	if p.SaltLength == 0 {
+		p.SaltLength = 16
+	}
+	if p.KeyLength == 0 {
+		p.KeyLength = 32
+	}
+	return argon2idScheme{params: p}
+}
+
+func (a argon2idScheme) Recognizes(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+// Hash returns the hash in the PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func (a argon2idScheme) Hash(password string) (string, error) {
+	salt := make([]byte, a.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, a.params.Iterations, a.params.Memory, a.params.Parallelism, a.params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		a.params.Memory, a.params.Iterations, a.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a argon2idScheme) Verify(password, encoded string) (bool, bool, error) {
+	stored, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, false, err
+	}
+	other := argon2.IDKey([]byte(password), salt, stored.Iterations, stored.Memory, stored.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false, nil
+	}
+
+	rehash := stored.Memory != a.params.Memory ||
+		stored.Iterations != a.params.Iterations ||
+		stored.Parallelism != a.params.Parallelism ||
+		uint32(len(salt)) != a.params.SaltLength ||
+		uint32(len(key)) != a.params.KeyLength
+	return true, rehash, nil
+}
+
+func decodeArgon2id(encoded string) (Argon2Params, []byte, []byte, error) {
+	var p Argon2Params
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return p, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	if version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("%w: unsupported argon2 version %d", ErrInvalidHash, version)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
@@ -0,0 +1,54 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptParams struct {
+	Cost int `yaml:"cost"`
+}
+
+type bcryptScheme struct {
+	cost int
+}
+
+func newBcrypt(p BcryptParams) bcryptScheme {
+	if p.Cost == 0 {
+		p.Cost = bcrypt.DefaultCost
+	}
+	return bcryptScheme{cost: p.Cost}
+}
+
+func (b bcryptScheme) Recognizes(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") ||
+		strings.HasPrefix(encoded, "$2b$") ||
+		strings.HasPrefix(encoded, "$2y$")
+}
+
+func (b bcryptScheme) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
+	}
+	return string(hash), nil
+}
+
+func (b bcryptScheme) Verify(password, encoded string) (bool, bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return false, false, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	return true, cost != b.cost, nil
+}
@@ -0,0 +1,133 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Параметры поменьше, чтобы тесты не считали хэши по секунде.
+var (
+	fastArgon2 = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+	fastBcrypt = BcryptParams{Cost: bcrypt.MinCost}
+)
+
+func newHasher(t *testing.T, cfg Config) Hasher {
+	t.Helper()
+	hasher, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hasher
+}
+
+func verify(t *testing.T, hasher Hasher, password, encoded string) (ok, rehash bool) {
+	t.Helper()
+	ok, rehash, err := hasher.Verify(password, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ok, rehash
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, cfg := range []Config{
+		{Algorithm: AlgorithmArgon2id, Argon2id: fastArgon2},
+		{Algorithm: AlgorithmBcrypt, Bcrypt: fastBcrypt},
+	} {
+		t.Run(cfg.Algorithm, func(t *testing.T) {
+			hasher := newHasher(t, cfg)
+			encoded, err := hasher.Hash("correct horse 1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(encoded, "$") || strings.Contains(encoded, "correct horse") {
+				t.Fatalf("hash %q", encoded)
+			}
+
+			if ok, rehash := verify(t, hasher, "correct horse 1", encoded); !ok || rehash {
+				t.Errorf("right password: ok %v, rehash %v, want true, false", ok, rehash)
+			}
+			if ok, _ := verify(t, hasher, "correct horse 2", encoded); ok {
+				t.Error("wrong password accepted")
+			}
+
+			again, err := hasher.Hash("correct horse 1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again == encoded {
+				t.Error("two hashes of a password are equal, the salt is not random")
+			}
+		})
+	}
+}
+
+func TestArgon2idFormat(t *testing.T) {
+	encoded, err := newHasher(t, Config{Argon2id: fastArgon2}).Hash("password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "$argon2id$v=19$m=1024,t=1,p=1$"; !strings.HasPrefix(encoded, want) {
+		t.Errorf("hash %q, want prefix %q", encoded, want)
+	}
+}
+
+func TestRehashOnParameterChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new Config
+	}{
+		{"argon2id memory", Config{Argon2id: fastArgon2}, Config{Argon2id: Argon2Params{Memory: 2048, Iterations: 1, Parallelism: 1}}},
+		{"argon2id key length", Config{Argon2id: fastArgon2}, Config{Argon2id: Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, KeyLength: 64}}},
+		{"bcrypt cost", Config{Algorithm: AlgorithmBcrypt, Bcrypt: fastBcrypt}, Config{Algorithm: AlgorithmBcrypt, Bcrypt: BcryptParams{Cost: bcrypt.MinCost + 1}}},
+		{"bcrypt to argon2id", Config{Algorithm: AlgorithmBcrypt, Bcrypt: fastBcrypt}, Config{Algorithm: AlgorithmArgon2id, Argon2id: fastArgon2}},
+		{"argon2id to bcrypt", Config{Argon2id: fastArgon2}, Config{Algorithm: AlgorithmBcrypt, Argon2id: fastArgon2, Bcrypt: fastBcrypt}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := newHasher(t, tt.old).Hash("password1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok, rehash := verify(t, newHasher(t, tt.new), "password1", encoded); !ok || !rehash {
+				t.Errorf("ok %v, rehash %v, want true, true", ok, rehash)
+			}
+		})
+	}
+}
+
+func TestLegacyPlaintext(t *testing.T) {
+	hasher := newHasher(t, Config{Argon2id: fastArgon2})
+	for _, stored := range []string{"password1", "$ecret123", "$2x$not-bcrypt"} {
+		if ok, rehash := verify(t, hasher, stored, stored); !ok || !rehash {
+			t.Errorf("%q: ok %v, rehash %v, want true, true", stored, ok, rehash)
+		}
+		if ok, _ := verify(t, hasher, stored+"x", stored); ok {
+			t.Errorf("%q: wrong password accepted", stored)
+		}
+	}
+}
+
+func TestInvalidHash(t *testing.T) {
+	hasher := newHasher(t, Config{Argon2id: fastArgon2})
+	for _, encoded := range []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5",
+		"$2b$04$short",
+	} {
+		ok, _, err := hasher.Verify("password1", encoded)
+		if ok || !errors.Is(err, ErrInvalidHash) {
+			t.Errorf("%q: ok %v, err %v, want ErrInvalidHash", encoded, ok, err)
+		}
+	}
+}
+
+func TestUnknownAlgorithm(t *testing.T) {
+	if _, err := New(Config{Algorithm: "md5"}); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("err %v, want ErrUnknownAlgorithm", err)
+	}
+}
@@ -0,0 +1,23 @@
+package principal
+
+import "context"
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserID  int
+	Token   string
+	IsAdmin bool
+}
+
+type key int
+
+var myKey key = 6
+
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, myKey, p)
+}
+
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(myKey).(Principal)
+	return p, ok
+}
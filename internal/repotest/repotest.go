@@ -0,0 +1,598 @@
+// Package repotest is the conformance suite of api.Repository: every
+// implementation (internal/db, internal/memory, internal/sqlite) must behave
+// the same on it. The Postgres run needs a database in TEST_BOOKSERVER_DSN and
+// is skipped without one, except in CI (.github/workflows/test.yml).
+//
+//	func TestRepository(t *testing.T) {
+//		repotest.Run(t, func(t *testing.T) api.Repository {
+//			return memory.NewRepository(domain.SessionTTL{})
+//		})
+//	}
+package repotest
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"bookserver_git/internal/principal"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Run runs the suite. newRepository must return an empty repository, it is
+// called once per subtest.
+func Run(t *testing.T, newRepository func(t *testing.T) api.Repository) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo api.Repository)
+	}{
+		{"Books", testBooks},
+		{"UniqueBooks", testUniqueBooks},
+		{"Versions", testVersions},
+		{"Owner", testOwner},
+		{"ListBooks", testListBooks},
+		{"Authors", testAuthors},
+		{"MergeAuthors", testMergeAuthors},
+		{"Users", testUsers},
+		{"Sessions", testSessions},
+		{"Trash", testTrash},
+		{"Revisions", testRevisions},
+		{"Import", testImport},
+		{"WithTx", testWithTx},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepository(t))
+		})
+	}
+}
+
+func newUser(t *testing.T, repo api.Repository, email string) domain.User {
+	t.Helper()
+	user, err := repo.SaveUserToDatabase(context.Background(), domain.User{Email: email, Password: "hash"})
+	if err != nil {
+		t.Fatalf("SaveUserToDatabase(%q): %v", email, err)
+	}
+	return user
+}
+
+// asUser is the context of an RPC made by the user, revisions take the actor from it.
+func asUser(user domain.User) context.Context {
+	return principal.NewContext(context.Background(), principal.Principal{UserID: user.ID})
+}
+
+func newBook(t *testing.T, repo api.Repository, ctx context.Context, book domain.Book) domain.Book {
+	t.Helper()
+	saved, err := repo.SaveBookToDatabase(book, ctx)
+	if err != nil {
+		t.Fatalf("SaveBookToDatabase(%q): %v", book.Title, err)
+	}
+	return saved
+}
+
+func wantKind(t *testing.T, op string, err error, kind errs.Kind) {
+	t.Helper()
+	if !errs.Is(err, kind) {
+		t.Fatalf("%s: got error %v, want %s", op, err, kind)
+	}
+}
+
+// byID are the authors for domain.Book.Authors, repositories take them by ID.
+func byID(ids ...int) []domain.Author {
+	authors := make([]domain.Author, len(ids))
+	for i, id := range ids {
+		authors[i].ID = id
+	}
+	return authors
+}
+
+func authorIDs(book domain.Book) []int {
+	ids := make([]int, len(book.Authors))
+	for i, author := range book.Authors {
+		ids[i] = author.ID
+	}
+	return ids
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testBooks(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+
+	saved := newBook(t, repo, ctx, domain.Book{Title: "dune", Year: 1965, UserID: user.ID, ISBN: "9780441013593", Edition: "1"})
+	if saved.ID == 0 || saved.Version != 1 || saved.Title != "dune" {
+		t.Fatalf("SaveBookToDatabase = %+v", saved)
+	}
+	other := newBook(t, repo, ctx, domain.Book{Title: "emma", Year: 1815, UserID: user.ID})
+	if other.ID <= saved.ID {
+		t.Errorf("ids are not increasing: %d after %d", other.ID, saved.ID)
+	}
+
+	got, err := repo.GetBookFromDatebase(uint(saved.ID), ctx)
+	if err != nil || got.Title != "dune" || got.Year != 1965 || got.Edition != "1" {
+		t.Fatalf("GetBookFromDatebase = %+v, %v", got, err)
+	}
+	got, err = repo.GetBookByIsbn(ctx, "9780441013593")
+	if err != nil || got.ID != saved.ID {
+		t.Fatalf("GetBookByIsbn = %+v, %v", got, err)
+	}
+	_, err = repo.GetBookFromDatebase(uint(other.ID+100), ctx)
+	wantKind(t, "GetBookFromDatebase of a missing book", err, errs.KindNotFound)
+	_, err = repo.GetBookByIsbn(ctx, "9780000000002")
+	wantKind(t, "GetBookByIsbn of a missing book", err, errs.KindNotFound)
+
+	updated, err := repo.UpdateBookFromDatabase(domain.Book{ID: saved.ID, Title: "dune messiah", Year: 1969}, []string{"title"}, ctx)
+	if err != nil || updated.Title != "dune messiah" || updated.Year != 1965 || updated.Version != 2 {
+		t.Fatalf("UpdateBookFromDatabase = %+v, %v", updated, err)
+	}
+	_, err = repo.UpdateBookFromDatabase(domain.Book{ID: saved.ID}, []string{"user_id"}, ctx)
+	wantKind(t, "UpdateBookFromDatabase of an unknown field", err, errs.KindInvalidArgument)
+
+	if err := repo.DeleteBookFromDatebase(uint(other.ID), 0, ctx); err != nil {
+		t.Fatalf("DeleteBookFromDatebase: %v", err)
+	}
+	_, err = repo.GetBookFromDatebase(uint(other.ID), ctx)
+	wantKind(t, "GetBookFromDatebase of a deleted book", err, errs.KindNotFound)
+	err = repo.DeleteBookFromDatebase(uint(other.ID), 0, ctx)
+	wantKind(t, "DeleteBookFromDatebase of a deleted book", err, errs.KindNotFound)
+}
+
+func testUniqueBooks(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+
+	first := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID, ISBN: "9780441013593"})
+	_, err := repo.SaveBookToDatabase(domain.Book{Title: "other", UserID: user.ID, ISBN: "9780441013593"}, ctx)
+	wantKind(t, "SaveBookToDatabase with a taken isbn", err, errs.KindAlreadyExists)
+
+	// Без ISBN уникальны название и издание.
+	newBook(t, repo, ctx, domain.Book{Title: "emma", Edition: "1", UserID: user.ID})
+	newBook(t, repo, ctx, domain.Book{Title: "emma", Edition: "2", UserID: user.ID})
+	_, err = repo.SaveBookToDatabase(domain.Book{Title: "emma", Edition: "1", UserID: user.ID}, ctx)
+	wantKind(t, "SaveBookToDatabase with a taken title and edition", err, errs.KindAlreadyExists)
+	newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID})
+
+	second := newBook(t, repo, ctx, domain.Book{Title: "ulysses", UserID: user.ID, ISBN: "9780679722762"})
+	_, err = repo.UpdateBookFromDatabase(domain.Book{ID: second.ID, ISBN: first.ISBN}, []string{"isbn"}, ctx)
+	wantKind(t, "UpdateBookFromDatabase to a taken isbn", err, errs.KindAlreadyExists)
+
+	// Книга в корзине не занимает ISBN.
+	if err := repo.DeleteBookFromDatebase(uint(first.ID), 0, ctx); err != nil {
+		t.Fatalf("DeleteBookFromDatebase: %v", err)
+	}
+	newBook(t, repo, ctx, domain.Book{Title: "dune again", UserID: user.ID, ISBN: "9780441013593"})
+}
+
+func testVersions(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID})
+
+	_, err := repo.UpdateBookFromDatabase(domain.Book{ID: book.ID, Title: "x", Version: book.Version + 1}, []string{"title"}, ctx)
+	wantKind(t, "UpdateBookFromDatabase of a stale version", err, errs.KindAborted)
+	updated, err := repo.UpdateBookFromDatabase(domain.Book{ID: book.ID, Year: 1965, Version: book.Version}, []string{"year"}, ctx)
+	if err != nil || updated.Version != book.Version+1 {
+		t.Fatalf("UpdateBookFromDatabase = %+v, %v", updated, err)
+	}
+
+	err = repo.DeleteBookFromDatebase(uint(book.ID), book.Version, ctx)
+	wantKind(t, "DeleteBookFromDatebase of a stale version", err, errs.KindAborted)
+	if err := repo.DeleteBookFromDatebase(uint(book.ID), updated.Version, ctx); err != nil {
+		t.Fatalf("DeleteBookFromDatebase: %v", err)
+	}
+}
+
+func testOwner(t *testing.T, repo api.Repository) {
+	owner := newUser(t, repo, "owner@example.com")
+	stranger := newUser(t, repo, "stranger@example.com")
+	ctx := asUser(owner)
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: owner.ID})
+
+	_, err := repo.UpdateBookOwnedBy(ctx, domain.Book{ID: book.ID, Title: "x"}, []string{"title"}, stranger.ID)
+	wantKind(t, "UpdateBookOwnedBy of someone else's book", err, errs.KindPermissionDenied)
+	_, err = repo.UpdateBookOwnedBy(ctx, domain.Book{ID: book.ID + 100, Title: "x"}, []string{"title"}, owner.ID)
+	wantKind(t, "UpdateBookOwnedBy of a missing book", err, errs.KindNotFound)
+	err = repo.DeleteBookOwnedBy(ctx, uint(book.ID), 0, stranger.ID)
+	wantKind(t, "DeleteBookOwnedBy of someone else's book", err, errs.KindPermissionDenied)
+
+	if _, err := repo.UpdateBookOwnedBy(ctx, domain.Book{ID: book.ID, Title: "dune"}, []string{"title"}, owner.ID); err != nil {
+		t.Fatalf("UpdateBookOwnedBy: %v", err)
+	}
+	if err := repo.DeleteBookOwnedBy(ctx, uint(book.ID), 0, owner.ID); err != nil {
+		t.Fatalf("DeleteBookOwnedBy: %v", err)
+	}
+}
+
+func testListBooks(t *testing.T, repo api.Repository) {
+	alice := newUser(t, repo, "alice@example.com")
+	bob := newUser(t, repo, "bob@example.com")
+	ctx := asUser(alice)
+	for i, title := range []string{"delta", "alpha", "charlie", "bravo"} {
+		owner := alice
+		if i%2 == 1 {
+			owner = bob
+		}
+		newBook(t, repo, ctx, domain.Book{Title: title, Year: 2000 + i, UserID: owner.ID})
+	}
+
+	var titles []string
+	query := domain.BookQuery{OrderBy: "title", Limit: 3}
+	for {
+		books, err := repo.AllBooksFromDatabase(ctx, query)
+		if err != nil {
+			t.Fatalf("AllBooksFromDatabase: %v", err)
+		}
+		for _, book := range books {
+			titles = append(titles, book.Title)
+		}
+		if len(books) < query.Limit {
+			break
+		}
+		query.After = &books[len(books)-1]
+	}
+	if want := []string{"alpha", "bravo", "charlie", "delta"}; len(titles) != len(want) || titles[0] != want[0] || titles[3] != want[3] {
+		t.Errorf("pages by title = %v, want %v", titles, want)
+	}
+
+	books, err := repo.AllBooksFromDatabase(ctx, domain.BookQuery{OrderBy: "year", Desc: true, Limit: 10})
+	if err != nil || len(books) != 4 || books[0].Title != "bravo" {
+		t.Errorf("AllBooksFromDatabase by year desc = %v, %v", books, err)
+	}
+	_, err = repo.AllBooksFromDatabase(ctx, domain.BookQuery{OrderBy: "publisher", Limit: 10})
+	wantKind(t, "AllBooksFromDatabase by an unknown order", err, errs.KindInvalidArgument)
+
+	yearMin := 2001
+	filter := domain.BookFilter{TitleContains: "a", YearMin: &yearMin, OwnerID: bob.ID}
+	count, err := repo.CountBooks(ctx, filter)
+	if err != nil || count != 2 {
+		t.Errorf("CountBooks(%+v) = %d, %v, want 2", filter, count, err)
+	}
+
+	var exported int
+	err = repo.ExportBooks(ctx, domain.BookFilter{OwnerID: alice.ID}, func(domain.Book) error {
+		exported++
+		return nil
+	})
+	if err != nil || exported != 2 {
+		t.Errorf("ExportBooks = %d books, %v, want 2", exported, err)
+	}
+
+	stop := errors.New("stop")
+	var streamed int
+	err = repo.StreamBooks(ctx, domain.BookQuery{OrderBy: "id", Limit: 10}, func(domain.Book) error {
+		streamed++
+		return stop
+	})
+	if !errors.Is(err, stop) || streamed != 1 {
+		t.Errorf("StreamBooks = %d books, %v, want the error of fn", streamed, err)
+	}
+}
+
+func testAuthors(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+
+	author, err := repo.SaveAuthor(ctx, domain.Author{Name: "frank herbert"})
+	if err != nil || author.ID == 0 {
+		t.Fatalf("SaveAuthor = %+v, %v", author, err)
+	}
+	_, err = repo.SaveAuthor(ctx, domain.Author{Name: "frank herbert"})
+	wantKind(t, "SaveAuthor with a taken name", err, errs.KindAlreadyExists)
+	_, err = repo.GetAuthor(ctx, author.ID+100)
+	wantKind(t, "GetAuthor of a missing author", err, errs.KindNotFound)
+
+	ensured, err := repo.EnsureAuthors(ctx, []string{"frank herbert", "brian herbert"})
+	if err != nil || len(ensured) != 2 || ensured[0].ID != author.ID || ensured[1].ID == author.ID {
+		t.Fatalf("EnsureAuthors = %+v, %v", ensured, err)
+	}
+	authors, err := repo.ListAuthors(ctx, "herbert", 0, 1)
+	if err != nil || len(authors) != 1 || authors[0].ID != author.ID {
+		t.Errorf("ListAuthors = %+v, %v", authors, err)
+	}
+
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID, Authors: byID(author.ID)})
+	if !equalInts(authorIDs(book), []int{author.ID}) || book.Version != 1 {
+		t.Errorf("SaveBookToDatabase with authors = %+v", book)
+	}
+	want := []int{ensured[1].ID, author.ID}
+	updated, err := repo.UpdateBookFromDatabase(domain.Book{ID: book.ID, Authors: byID(want...)}, []string{domain.BookAuthorsField}, ctx)
+	if err != nil || !equalInts(authorIDs(updated), want) || updated.Version != book.Version+1 {
+		t.Errorf("UpdateBookFromDatabase of authors = %+v, %v, want authors %v", updated, err, want)
+	}
+	got, err := repo.GetBookFromDatebase(uint(book.ID), ctx)
+	if err != nil || !equalInts(authorIDs(got), want) {
+		t.Errorf("book after the update of authors = %+v, %v, want authors %v", got, err, want)
+	}
+	_, err = repo.UpdateBookFromDatabase(domain.Book{ID: book.ID, Authors: byID(author.ID + 100)}, []string{domain.BookAuthorsField}, ctx)
+	wantKind(t, "UpdateBookFromDatabase with a missing author", err, errs.KindNotFound)
+	_, err = repo.SaveBookToDatabase(domain.Book{Title: "emma", UserID: user.ID, Authors: byID(author.ID + 100)}, ctx)
+	wantKind(t, "SaveBookToDatabase with a missing author", err, errs.KindNotFound)
+}
+
+func testMergeAuthors(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+	authors, err := repo.EnsureAuthors(ctx, []string{"target", "source", "co-author"})
+	if err != nil {
+		t.Fatalf("EnsureAuthors: %v", err)
+	}
+	target, source, coAuthor := authors[0].ID, authors[1].ID, authors[2].ID
+
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID, Authors: byID(source, coAuthor)})
+	both := newBook(t, repo, ctx, domain.Book{Title: "emma", UserID: user.ID, Authors: byID(target, source)})
+
+	_, err = repo.MergeAuthors(ctx, target, []int{source, coAuthor + 100})
+	wantKind(t, "MergeAuthors of a missing author", err, errs.KindNotFound)
+	merged, err := repo.MergeAuthors(ctx, target, []int{source})
+	if err != nil || merged.ID != target {
+		t.Fatalf("MergeAuthors = %+v, %v", merged, err)
+	}
+
+	got, _ := repo.GetBookFromDatebase(uint(book.ID), ctx)
+	if want := []int{target, coAuthor}; !equalInts(authorIDs(got), want) {
+		t.Errorf("authors after MergeAuthors = %v, want %v", authorIDs(got), want)
+	}
+	got, _ = repo.GetBookFromDatebase(uint(both.ID), ctx)
+	if want := []int{target}; !equalInts(authorIDs(got), want) {
+		t.Errorf("authors after MergeAuthors = %v, want %v", authorIDs(got), want)
+	}
+	_, err = repo.GetAuthor(ctx, source)
+	wantKind(t, "GetAuthor of a merged author", err, errs.KindNotFound)
+}
+
+func testUsers(t *testing.T, repo api.Repository) {
+	ctx := context.Background()
+	user := newUser(t, repo, "user@example.com")
+	if user.ID == 0 || user.IsAdmin {
+		t.Fatalf("SaveUserToDatabase = %+v", user)
+	}
+	_, err := repo.SaveUserToDatabase(ctx, domain.User{Email: "user@example.com", Password: "hash"})
+	wantKind(t, "SaveUserToDatabase with a taken email", err, errs.KindAlreadyExists)
+
+	if err := repo.UpdateUserPassword(ctx, user.ID, "new hash"); err != nil {
+		t.Fatalf("UpdateUserPassword: %v", err)
+	}
+	got, err := repo.GetUserByEmail(ctx, "user@example.com")
+	if err != nil || got.ID != user.ID || got.Password != "new hash" {
+		t.Errorf("GetUserByEmail = %+v, %v", got, err)
+	}
+	_, err = repo.GetUserByEmail(ctx, "nobody@example.com")
+	wantKind(t, "GetUserByEmail of a missing user", err, errs.KindNotFound)
+}
+
+func testSessions(t *testing.T, repo api.Repository) {
+	ctx := context.Background()
+	user := newUser(t, repo, "user@example.com")
+	other := newUser(t, repo, "other@example.com")
+	for _, session := range []domain.Session{
+		{UserID: user.ID, Token: "first"},
+		{UserID: user.ID, Token: "second"},
+		{UserID: user.ID, Token: "third"},
+		{UserID: other.ID, Token: "other"},
+	} {
+		if err := repo.SaveSessionToDatabase(ctx, session); err != nil {
+			t.Fatalf("SaveSessionToDatabase: %v", err)
+		}
+	}
+
+	got, err := repo.GetUserByToken(ctx, "second")
+	if err != nil || got.ID != user.ID {
+		t.Fatalf("GetUserByToken = %+v, %v", got, err)
+	}
+	_, err = repo.GetUserByToken(ctx, "unknown")
+	wantKind(t, "GetUserByToken of an unknown token", err, errs.KindNotFound)
+
+	sessions, err := repo.ListUserSessions(ctx, user.ID)
+	if err != nil || len(sessions) != 3 || sessions[0].Token != "second" {
+		t.Fatalf("ListUserSessions = %+v, %v, want the last used first", sessions, err)
+	}
+
+	err = repo.DeleteUserSession(ctx, other.ID, sessions[1].ID)
+	wantKind(t, "DeleteUserSession of someone else's session", err, errs.KindNotFound)
+	if err := repo.DeleteUserSession(ctx, user.ID, sessions[1].ID); err != nil {
+		t.Fatalf("DeleteUserSession: %v", err)
+	}
+	deleted, err := repo.DeleteUserSessions(ctx, user.ID, "second")
+	if err != nil || deleted != 1 {
+		t.Errorf("DeleteUserSessions = %d, %v, want 1", deleted, err)
+	}
+	if err := repo.DeleteSessionByToken(ctx, "second"); err != nil {
+		t.Fatalf("DeleteSessionByToken: %v", err)
+	}
+	_, err = repo.GetUserByToken(ctx, "second")
+	wantKind(t, "GetUserByToken after logout", err, errs.KindNotFound)
+	if _, err := repo.GetUserByToken(ctx, "other"); err != nil {
+		t.Errorf("GetUserByToken of another user's session: %v", err)
+	}
+}
+
+func testTrash(t *testing.T, repo api.Repository) {
+	owner := newUser(t, repo, "owner@example.com")
+	stranger := newUser(t, repo, "stranger@example.com")
+	ctx := asUser(owner)
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: owner.ID, ISBN: "9780441013593"})
+	kept := newBook(t, repo, ctx, domain.Book{Title: "emma", UserID: owner.ID})
+
+	_, err := repo.UndeleteBook(ctx, book.ID)
+	wantKind(t, "UndeleteBook of a live book", err, errs.KindNotFound)
+	for _, id := range []int{book.ID, kept.ID} {
+		if err := repo.DeleteBookFromDatebase(uint(id), 0, ctx); err != nil {
+			t.Fatalf("DeleteBookFromDatebase: %v", err)
+		}
+	}
+
+	trash, err := repo.AllBooksFromDatabase(ctx, domain.BookQuery{Filter: domain.BookFilter{Deleted: true}, OrderBy: "id", Limit: 10})
+	if err != nil || len(trash) != 2 || trash[0].DeletedAt == nil {
+		t.Fatalf("books in the trash = %+v, %v", trash, err)
+	}
+	if count, _ := repo.CountBooks(ctx, domain.BookFilter{}); count != 0 {
+		t.Errorf("CountBooks of live books = %d, want 0", count)
+	}
+
+	_, err = repo.UndeleteBookOwnedBy(ctx, book.ID, stranger.ID)
+	wantKind(t, "UndeleteBookOwnedBy of someone else's book", err, errs.KindPermissionDenied)
+	restored, err := repo.UndeleteBookOwnedBy(ctx, book.ID, owner.ID)
+	if err != nil || restored.DeletedAt != nil || restored.Version != book.Version+2 {
+		t.Fatalf("UndeleteBookOwnedBy = %+v, %v", restored, err)
+	}
+
+	// Пока книга в корзине, её ISBN может занять другая.
+	if err := repo.DeleteBookFromDatebase(uint(book.ID), 0, ctx); err != nil {
+		t.Fatalf("DeleteBookFromDatebase: %v", err)
+	}
+	newBook(t, repo, ctx, domain.Book{Title: "dune again", UserID: owner.ID, ISBN: "9780441013593"})
+	_, err = repo.UndeleteBook(ctx, book.ID)
+	wantKind(t, "UndeleteBook with a taken isbn", err, errs.KindAlreadyExists)
+
+	purged, err := repo.PurgeDeletedBooks(ctx, time.Hour)
+	if err != nil || purged != 0 {
+		t.Errorf("PurgeDeletedBooks(1h) = %d, %v, want 0", purged, err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	purged, err = repo.PurgeDeletedBooks(ctx, time.Millisecond)
+	if err != nil || purged != 2 {
+		t.Errorf("PurgeDeletedBooks = %d, %v, want 2", purged, err)
+	}
+	_, err = repo.UndeleteBook(ctx, kept.ID)
+	wantKind(t, "UndeleteBook of a purged book", err, errs.KindNotFound)
+}
+
+func testRevisions(t *testing.T, repo api.Repository) {
+	owner := newUser(t, repo, "owner@example.com")
+	stranger := newUser(t, repo, "stranger@example.com")
+	ctx := asUser(owner)
+	authors, err := repo.EnsureAuthors(ctx, []string{"frank herbert", "brian herbert"})
+	if err != nil {
+		t.Fatalf("EnsureAuthors: %v", err)
+	}
+
+	// Поля и авторы меняются одной записью, и в истории она одна.
+	book := newBook(t, repo, ctx, domain.Book{Title: "dune", Year: 1965, UserID: owner.ID, Authors: byID(authors[0].ID)})
+	fields := []string{"title", domain.BookAuthorsField}
+	updated, err := repo.UpdateBookFromDatabase(domain.Book{ID: book.ID, Title: "dune messiah", Authors: byID(authors[1].ID)}, fields, ctx)
+	if err != nil || updated.Version != book.Version+1 {
+		t.Fatalf("UpdateBookFromDatabase = %+v, %v", updated, err)
+	}
+
+	revisions, err := repo.ListBookRevisions(ctx, book.ID, owner.ID, 0, 10)
+	if err != nil || len(revisions) != 2 {
+		t.Fatalf("ListBookRevisions = %+v, %v, want 2 revisions", revisions, err)
+	}
+	created := revisions[0]
+	if created.Action != domain.RevisionCreate || created.Before != nil || created.After == nil ||
+		created.ActorID != owner.ID || created.Version != 1 || !equalInts(authorIDs(*created.After), []int{authors[0].ID}) {
+		t.Errorf("first revision = %+v", created)
+	}
+	last := revisions[1]
+	if last.Action != domain.RevisionUpdate || last.Version != updated.Version ||
+		last.Before.Title != "dune" || !equalInts(authorIDs(*last.Before), []int{authors[0].ID}) ||
+		last.After.Title != "dune messiah" || !equalInts(authorIDs(*last.After), []int{authors[1].ID}) {
+		t.Errorf("last revision = %+v", last)
+	}
+	page, err := repo.ListBookRevisions(ctx, book.ID, 0, revisions[0].ID, 1)
+	if err != nil || len(page) != 1 || page[0].ID != revisions[1].ID {
+		t.Errorf("ListBookRevisions after the first = %+v, %v", page, err)
+	}
+	_, err = repo.ListBookRevisions(ctx, book.ID, stranger.ID, 0, 10)
+	wantKind(t, "ListBookRevisions of someone else's book", err, errs.KindPermissionDenied)
+	_, err = repo.ListBookRevisions(ctx, book.ID+100, 0, 0, 10)
+	wantKind(t, "ListBookRevisions of a missing book", err, errs.KindNotFound)
+
+	_, err = repo.RevertBookToRevision(ctx, book.ID, created.ID, book.Version)
+	wantKind(t, "RevertBookToRevision of a stale version", err, errs.KindAborted)
+	_, err = repo.RevertBookToRevisionOwnedBy(ctx, book.ID, created.ID, 0, stranger.ID)
+	wantKind(t, "RevertBookToRevisionOwnedBy of someone else's book", err, errs.KindPermissionDenied)
+	_, err = repo.RevertBookToRevision(ctx, book.ID, last.ID+100, 0)
+	wantKind(t, "RevertBookToRevision to a missing revision", err, errs.KindNotFound)
+
+	reverted, err := repo.RevertBookToRevision(ctx, book.ID, created.ID, updated.Version)
+	if err != nil || reverted.Title != "dune" || !equalInts(authorIDs(reverted), []int{authors[0].ID}) ||
+		reverted.Version != updated.Version+1 {
+		t.Fatalf("RevertBookToRevision = %+v, %v", reverted, err)
+	}
+	revisions, _ = repo.ListBookRevisions(ctx, book.ID, 0, last.ID, 10)
+	if len(revisions) != 1 || revisions[0].Action != domain.RevisionRevert {
+		t.Errorf("revisions after revert = %+v", revisions)
+	}
+}
+
+func testImport(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+	existing := newBook(t, repo, ctx, domain.Book{Title: "dune", UserID: user.ID, ISBN: "9780441013593"})
+
+	books := []domain.Book{
+		{Title: "emma", UserID: user.ID, Authors: []domain.Author{{Name: "jane austen"}}},
+		{Title: "dune", UserID: user.ID, ISBN: existing.ISBN},
+		{Title: "ulysses", UserID: user.ID, Authors: []domain.Author{{ID: 1000}}},
+	}
+	results, err := repo.ImportBooks(ctx, books, true)
+	if err != nil || len(results) != 3 {
+		t.Fatalf("ImportBooks dry run = %+v, %v", results, err)
+	}
+	if results[0].ID == 0 || results[0].Err != nil || results[1].ID != 0 || results[1].Err != nil || results[2].Err == nil {
+		t.Errorf("ImportBooks dry run results = %+v", results)
+	}
+	if count, _ := repo.CountBooks(ctx, domain.BookFilter{}); count != 1 {
+		t.Errorf("CountBooks after a dry run = %d, want 1", count)
+	}
+
+	results, err = repo.ImportBooks(ctx, books[:2], false)
+	if err != nil || results[0].ID == 0 {
+		t.Fatalf("ImportBooks = %+v, %v", results, err)
+	}
+	imported, err := repo.GetBookFromDatebase(uint(results[0].ID), ctx)
+	if err != nil || len(imported.Authors) != 1 || imported.Authors[0].Name != "jane austen" {
+		t.Errorf("imported book = %+v, %v", imported, err)
+	}
+}
+
+func testWithTx(t *testing.T, repo api.Repository) {
+	user := newUser(t, repo, "owner@example.com")
+	ctx := asUser(user)
+
+	fail := errors.New("fail")
+	err := repo.WithTx(ctx, func(tx api.Repository) error {
+		if _, err := tx.SaveBookToDatabase(domain.Book{Title: "dune", UserID: user.ID}, ctx); err != nil {
+			return err
+		}
+		return fail
+	})
+	if !errors.Is(err, fail) {
+		t.Fatalf("WithTx = %v, want the error of fn", err)
+	}
+	if count, _ := repo.CountBooks(ctx, domain.BookFilter{}); count != 0 {
+		t.Errorf("CountBooks after a rolled back WithTx = %d, want 0", count)
+	}
+
+	var id int
+	err = repo.WithTx(ctx, func(tx api.Repository) error {
+		book, err := tx.SaveBookToDatabase(domain.Book{Title: "dune", UserID: user.ID}, ctx)
+		if err != nil {
+			return err
+		}
+		id = book.ID
+		_, err = tx.UpdateBookFromDatabase(domain.Book{ID: book.ID, Year: 1965}, []string{"year"}, ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WithTx: %v", err)
+	}
+	book, err := repo.GetBookFromDatebase(uint(id), ctx)
+	if err != nil || book.Year != 1965 || book.Version != 2 {
+		t.Errorf("book after WithTx = %+v, %v", book, err)
+	}
+}
@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (d Repository) SaveAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	query := "INSERT INTO authors (name) VALUES(?1) RETURNING id, name"
+	err := d.db.QueryRowContext(ctx, query, author.Name).Scan(&author.ID, &author.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", author.Name)
+	}
+	return author, nil
+}
+
+func (d Repository) GetAuthor(ctx context.Context, id int) (domain.Author, error) {
+	var author domain.Author
+	query := "SELECT id, name FROM authors WHERE id = ?1"
+	err := d.db.QueryRowContext(ctx, query, id).Scan(&author.ID, &author.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", id)
+	}
+	return author, nil
+}
+
+// ListAuthors returns authors ordered by ID, starting after afterID.
+func (d Repository) ListAuthors(ctx context.Context, nameContains string, afterID int, limit int) ([]domain.Author, error) {
+	query := `SELECT id, name FROM authors
+		WHERE id > ?1 AND (?2 = '' OR name LIKE '%' || ?2 || '%' ESCAPE '\')
+		ORDER BY id
+		LIMIT ?3`
+	rows, err := d.db.QueryContext(ctx, query, afterID, likeEscaper.Replace(nameContains), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []domain.Author
+	for rows.Next() {
+		var author domain.Author
+		if err := rows.Scan(&author.ID, &author.Name); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+	return authors, rows.Err()
+}
+
+// ensureAuthorQuery returns the author with the name, creating it if needed.
+const ensureAuthorQuery = `INSERT INTO authors (name) VALUES(?1)
+	ON CONFLICT ((lower(name))) DO UPDATE SET name = authors.name
+	RETURNING id, name`
+
+// EnsureAuthors returns the authors with the given names, creating the missing
+// ones. Names are matched case-insensitively, for Latin letters only.
+func (d Repository) EnsureAuthors(ctx context.Context, names []string) ([]domain.Author, error) {
+	authors := make([]domain.Author, len(names))
+	for i, name := range names {
+		err := d.db.QueryRowContext(ctx, ensureAuthorQuery, name).Scan(&authors[i].ID, &authors[i].Name)
+		if err != nil {
+			return nil, dbError(err, "author", name)
+		}
+	}
+	return authors, nil
+}
+
+// setBookAuthors replaces the authors of the book keeping the given order.
+// Bumping the version and recording the revision is up to the caller, as part
+// of the write the authors belong to.
+func setBookAuthors(ctx context.Context, tx *sql.Tx, bookID int, authors []domain.Author) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM book_authors WHERE book_id = ?1", bookID)
+	if err != nil {
+		return err
+	}
+	query := "INSERT INTO book_authors (book_id, author_id, position) VALUES(?1,?2,?3) ON CONFLICT DO NOTHING"
+	for position, author := range authors {
+		_, err = tx.ExecContext(ctx, query, bookID, author.ID, position)
+		if err != nil {
+			return dbError(err, "author", author.ID)
+		}
+	}
+	return nil
+}
+
+// MergeAuthors moves the books of sourceIDs to targetID and deletes the sources.
+func (d Repository) MergeAuthors(ctx context.Context, targetID int, sourceIDs []int) (domain.Author, error) {
+	var target domain.Author
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		var err error
+		target, err = mergeAuthors(ctx, tx, targetID, sourceIDs)
+		return err
+	})
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return target, nil
+}
+
+func mergeAuthors(ctx context.Context, tx *sql.Tx, targetID int, sourceIDs []int) (domain.Author, error) {
+	var target domain.Author
+	err := tx.QueryRowContext(ctx, "SELECT id, name FROM authors WHERE id = ?1", targetID).Scan(&target.ID, &target.Name)
+	if err != nil {
+		return domain.Author{}, dbError(err, "author", targetID)
+	}
+
+	sources := jsonIDs(sourceIDs)
+	query := "SELECT count(*) FROM authors WHERE id IN (SELECT value FROM json_each(?1))"
+	var found int
+	err = tx.QueryRowContext(ctx, query, sources).Scan(&found)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	if found != len(sourceIDs) {
+		return domain.Author{}, errs.NotFound("author", fmt.Sprint(sourceIDs))
+	}
+
+	// Список авторов у этих книг меняется, значит и версия.
+	bookIDs, err := authorBookIDs(ctx, tx, sources)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	befores := make([]domain.Book, len(bookIDs))
+	for i, id := range bookIDs {
+		if befores[i], err = readBook(ctx, tx, id); err != nil {
+			return domain.Author{}, err
+		}
+	}
+	query = "UPDATE books SET version = version + 1 WHERE id IN (SELECT value FROM json_each(?1))"
+	_, err = tx.ExecContext(ctx, query, jsonIDs(bookIDs))
+	if err != nil {
+		return domain.Author{}, err
+	}
+
+	// Книга, у которой уже есть target, остаётся со своей позицией.
+	query = `INSERT INTO book_authors (book_id, author_id, position)
+		SELECT book_id, ?1, min(position) FROM book_authors
+		WHERE author_id IN (SELECT value FROM json_each(?2))
+		GROUP BY book_id
+		ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, targetID, sources)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM authors WHERE id IN (SELECT value FROM json_each(?1))", sources)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	for _, before := range befores {
+		after, err := readBook(ctx, tx, before.ID)
+		if err != nil {
+			return domain.Author{}, err
+		}
+		if err := recordRevision(ctx, tx, domain.RevisionUpdate, &before, &after); err != nil {
+			return domain.Author{}, err
+		}
+	}
+	return target, nil
+}
+
+// authorBookIDs returns the IDs of the books by any of the authors, authors
+// given by jsonIDs.
+func authorBookIDs(ctx context.Context, tx *sql.Tx, authors string) ([]int, error) {
+	query := `SELECT DISTINCT book_id FROM book_authors
+		WHERE author_id IN (SELECT value FROM json_each(?1))
+		ORDER BY book_id`
+	rows, err := tx.QueryContext(ctx, query, authors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
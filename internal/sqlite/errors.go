@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/errs"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// constraintFields maps what SQLite names in a unique violation, the columns
+// of the index or the index of an expression, to the request field that broke it.
+var constraintFields = map[string]string{
+	"books.isbn":                 "isbn",
+	"books.title, books.edition": "title",
+	"users.email":                "email",
+	"index 'authors_name_key'":   "name",
+}
+
+// dbError turns driver errors into errs.Error about the given resource.
+// Errors it does not recognise are returned as is.
+func dbError(err error, resource string, id any) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return errs.NotFound(resource, id).Wrap(err)
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		e := errs.AlreadyExists(resource, id).Wrap(err)
+		if field, ok := constraintFields[uniqueConstraint(sqliteErr)]; ok {
+			e.Fields = []errs.FieldViolation{{Field: field, Description: "already taken"}}
+		}
+		return e
+	}
+	// Ссылка на несуществующую запись, например author_id в book_authors.
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY {
+		return errs.NotFound(resource, id).Wrap(err)
+	}
+	return err
+}
+
+// uniqueConstraint cuts the constraint out of a message like
+// "constraint failed: UNIQUE constraint failed: books.isbn (2067)".
+func uniqueConstraint(err *sqlite.Error) string {
+	_, constraint, _ := strings.Cut(err.Error(), "UNIQUE constraint failed: ")
+	constraint, _, _ = strings.Cut(constraint, " (")
+	return constraint
+}
+
+// affectedOrNotFound gives errs.NotFound when the statement did not touch any row.
+func affectedOrNotFound(res sql.Result, resource string, id any) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errs.NotFound(resource, id)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"context"
+)
+
+// ExportBooks calls fn for every book that matches the filter, ordered by ID.
+// SQLite has no cursors to fetch from, but one statement already reads one
+// snapshot row by row (see StreamBooks). An error from fn stops the export.
+func (d Repository) ExportBooks(ctx context.Context, filter domain.BookFilter, fn func(domain.Book) error) error {
+	return d.StreamBooks(ctx, domain.BookQuery{Filter: filter, OrderBy: "id"}, fn)
+}
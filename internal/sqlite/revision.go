@@ -0,0 +1,178 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"bookserver_git/internal/principal"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// recordRevision writes the change of the book into its history. The actor is
+// the caller from ctx, if there is one.
+func recordRevision(ctx context.Context, tx *sql.Tx, action string, before, after *domain.Book) error {
+	var actorID int
+	if caller, ok := principal.FromContext(ctx); ok {
+		actorID = caller.UserID
+	}
+	book := after
+	if book == nil {
+		book = before
+	}
+	beforeJSON, err := revisionSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := revisionSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO book_revisions (book_id, version, action, actor_id, changed_at, before, after)
+		VALUES(?1,?2,?3,NULLIF(?4,0),?5,?6,?7)`
+	_, err = tx.ExecContext(ctx, query, book.ID, book.Version, action, actorID, timestamp(time.Now()), beforeJSON, afterJSON)
+	return err
+}
+
+// revisionSnapshot encodes the book as JSON text, nil stays NULL.
+func revisionSnapshot(book *domain.Book) (any, error) {
+	if book == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(book)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// ListBookRevisions returns the history of the book, oldest first, starting
+// after afterID. With userID other than 0 the book must belong to that user.
+// A missing book is errs.NotFound, a book in the trash has its history too.
+func (d Repository) ListBookRevisions(ctx context.Context, bookID int, userID int, afterID int, limit int) ([]domain.BookRevision, error) {
+	var owner int
+	err := d.db.QueryRowContext(ctx, "SELECT user_id FROM books WHERE id = ?1", bookID).Scan(&owner)
+	if err != nil {
+		return nil, dbError(err, "book", bookID)
+	}
+	if userID != 0 && owner != userID {
+		return nil, errs.PermissionDenied("book", bookID, "book belongs to another user")
+	}
+
+	query := `SELECT id, book_id, version, action, COALESCE(actor_id, 0), changed_at, before, after
+		FROM book_revisions
+		WHERE book_id = ?1 AND id > ?2
+		ORDER BY id
+		LIMIT ?3`
+	rows, err := d.db.QueryContext(ctx, query, bookID, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []domain.BookRevision
+	for rows.Next() {
+		var revision domain.BookRevision
+		var before, after []byte
+		err := rows.Scan(&revision.ID, &revision.BookID, &revision.Version, &revision.Action, &revision.ActorID, &revision.ChangedAt, &before, &after)
+		if err != nil {
+			return nil, err
+		}
+		if revision.Before, err = decodeSnapshot(before); err != nil {
+			return nil, err
+		}
+		if revision.After, err = decodeSnapshot(after); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, rows.Err()
+}
+
+func decodeSnapshot(data []byte) (*domain.Book, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var book domain.Book
+	if err := json.Unmarshal(data, &book); err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+// RevertBookToRevision puts the fields and the authors of the book back as
+// they were right after the revision. Authors deleted since then are skipped.
+// A non-zero version must match the stored one.
+func (d Repository) RevertBookToRevision(ctx context.Context, bookID int, revisionID int, version int) (domain.Book, error) {
+	return d.revertBook(ctx, bookID, revisionID, version, 0)
+}
+
+// RevertBookToRevisionOwnedBy reverts the book only if it belongs to userID.
+func (d Repository) RevertBookToRevisionOwnedBy(ctx context.Context, bookID int, revisionID int, version int, userID int) (domain.Book, error) {
+	return d.revertBook(ctx, bookID, revisionID, version, userID)
+}
+
+func (d Repository) revertBook(ctx context.Context, bookID, revisionID, version, userID int) (domain.Book, error) {
+	var book domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		var err error
+		book, err = revertBookTx(ctx, tx, bookID, revisionID, version, userID)
+		return err
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return book, nil
+}
+
+func revertBookTx(ctx context.Context, tx *sql.Tx, bookID, revisionID, version, userID int) (domain.Book, error) {
+	before, err := readBook(ctx, tx, bookID)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	if err := checkBookWrite(before, userID, version); err != nil {
+		return domain.Book{}, err
+	}
+
+	var snapshot []byte
+	query := "SELECT after FROM book_revisions WHERE id = ?1 AND book_id = ?2"
+	err = tx.QueryRowContext(ctx, query, revisionID, bookID).Scan(&snapshot)
+	if err != nil {
+		return domain.Book{}, dbError(err, "revision", revisionID)
+	}
+	target, err := decodeSnapshot(snapshot)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	if target == nil {
+		return domain.Book{}, errs.InvalidArgument("invalid revision_id",
+			errs.FieldViolation{Field: "revision_id", Description: "revision has no book state"})
+	}
+
+	target.ID = bookID
+	if _, err := updateBookFields(ctx, tx, *target, domain.BookFields); err != nil {
+		return domain.Book{}, err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM book_authors WHERE book_id = ?1", bookID); err != nil {
+		return domain.Book{}, err
+	}
+	query = `INSERT INTO book_authors (book_id, author_id, position)
+		SELECT ?1, id, ?3 FROM authors WHERE id = ?2
+		ON CONFLICT DO NOTHING`
+	for position, author := range target.Authors {
+		if _, err := tx.ExecContext(ctx, query, bookID, author.ID, position); err != nil {
+			return domain.Book{}, err
+		}
+	}
+
+	after, err := readBook(ctx, tx, bookID)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	if err := recordRevision(ctx, tx, domain.RevisionRevert, &before, &after); err != nil {
+		return domain.Book{}, err
+	}
+	return after, nil
+}
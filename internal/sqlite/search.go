@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"context"
+	"strings"
+)
+
+// SearchBooks looks the words up in the FTS5 table books_search, any of them
+// is enough. Unlike the Postgres search there is no similarity, so titles
+// with typos are not found.
+func (d Repository) SearchBooks(ctx context.Context, text string, limit int) ([]domain.BookSearchResult, error) {
+	match := searchMatch(text)
+	if match == "" {
+		return nil, nil
+	}
+	query := `SELECT ` + bookColumns + `, ` + bookAuthorsColumn + `, s.rank, s.snippet
+		FROM books JOIN (
+			SELECT rowid AS book_id, -bm25(books_search) AS rank,
+				highlight(books_search, 0, ?3, ?4) AS snippet
+			FROM books_search WHERE books_search MATCH ?1
+		) s ON s.book_id = books.id
+		WHERE deleted_at IS NULL
+		ORDER BY s.rank DESC, id
+		LIMIT ?2`
+	rows, err := d.db.QueryContext(ctx, query, match, limit, domain.SnippetStart, domain.SnippetStop)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.BookSearchResult
+	for rows.Next() {
+		var result domain.BookSearchResult
+		var authors string
+		err = scanBook(rows, &result.Book, &authors, &result.Rank, &result.Snippet)
+		if err != nil {
+			return nil, err
+		}
+		if result.Book.Authors, err = decodeAuthors(authors); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// searchMatch turns the text into an FTS5 query: every word is quoted, so the
+// FTS5 syntax in it is not interpreted, and the words are joined with OR.
+func searchMatch(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		words[i] = `"` + strings.ReplaceAll(word, `"`, `""`) + `"`
+	}
+	return strings.Join(words, " OR ")
+}
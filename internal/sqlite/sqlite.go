@@ -0,0 +1,579 @@
+// Package sqlite is the api.Repository on an SQLite file, for running the
+// service without Postgres. The schema is in migrations_sqlite.
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+type Repository struct {
+	conn *sql.DB
+	// db is conn or, inside WithTx, tx.
+	db         querier
+	tx         *sql.Tx
+	sessionTTL domain.SessionTTL
+}
+
+// Open opens the database file with the settings Repository relies on:
+// foreign keys, WAL so reads do not wait for writes, and transactions that
+// take the write lock at BEGIN instead of failing on it later.
+func Open(path string) (*sql.DB, error) {
+	return sql.Open("sqlite", "file:"+path+
+		"?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_txlock=immediate")
+}
+
+func NewRepository(rawDB *sql.DB, sessionTTL domain.SessionTTL) *Repository {
+	return &Repository{conn: rawDB, db: rawDB, sessionTTL: sessionTTL}
+}
+
+// timeLayout is how timestamps are stored: UTC text of fixed width, so that
+// they compare as strings. The driver reads it back into time.Time for
+// datetime columns.
+const timeLayout = "2006-01-02 15:04:05.000000000"
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// bookColumns is the column list read by scanBook.
+const bookColumns = "id, title, year_book, user_id, COALESCE(isbn, ''), edition, publisher, cover_url, version, deleted_at"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanBook reads bookColumns followed by the extra columns, if any.
+func scanBook(row scanner, book *domain.Book, extra ...any) error {
+	dest := []any{&book.ID, &book.Title, &book.Year, &book.UserID, &book.ISBN, &book.Edition, &book.Publisher, &book.CoverURL, &book.Version, &book.DeletedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// bookAuthorsColumn reads the authors together with the book as a JSON array
+// in position order. Use it with scanBookWithAuthors.
+const bookAuthorsColumn = `(SELECT json_group_array(json_object('id', au.id, 'name', au.name)) FROM (
+		SELECT au.id, au.name FROM book_authors ba JOIN authors au ON au.id = ba.author_id
+		WHERE ba.book_id = books.id
+		ORDER BY ba.position, au.id
+	) au)`
+
+func scanBookWithAuthors(row scanner, book *domain.Book) error {
+	var authors string
+	if err := scanBook(row, book, &authors); err != nil {
+		return err
+	}
+	var err error
+	book.Authors, err = decodeAuthors(authors)
+	return err
+}
+
+// decodeAuthors reads bookAuthorsColumn, a book without authors gets nil
+// like in internal/db.
+func decodeAuthors(data string) ([]domain.Author, error) {
+	var authors []domain.Author
+	if err := json.Unmarshal([]byte(data), &authors); err != nil {
+		return nil, err
+	}
+	if len(authors) == 0 {
+		return nil, nil
+	}
+	return authors, nil
+}
+
+// bookKey names the book in errors: by ISBN when it has one, else by title.
+func bookKey(book domain.Book) string {
+	if book.ISBN != "" {
+		return book.ISBN
+	}
+	return book.Title
+}
+
+// jsonIDs passes a list of IDs as one argument, read it with
+// "IN (SELECT value FROM json_each(?))".
+func jsonIDs(ids []int) string {
+	data, _ := json.Marshal(ids)
+	return string(data)
+}
+
+func (d Repository) SaveBookToDatabase(book domain.Book, ctx context.Context) (domain.Book, error) {
+	query := `INSERT INTO books (title, year_book, user_id, isbn, edition, publisher, cover_url)
+		VALUES(?1,?2,?3,NULLIF(?4,''),?5,?6,?7) RETURNING ` + bookColumns
+	var saved domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		err := scanBook(tx.QueryRowContext(ctx, query, book.Title, book.Year, book.UserID, book.ISBN, book.Edition, book.Publisher, book.CoverURL), &saved)
+		if err != nil {
+			return dbError(err, "book", bookKey(book))
+		}
+		if len(book.Authors) > 0 {
+			if err := setBookAuthors(ctx, tx, saved.ID, book.Authors); err != nil {
+				return err
+			}
+			if saved, err = readBook(ctx, tx, saved.ID); err != nil {
+				return err
+			}
+		}
+		return recordRevision(ctx, tx, domain.RevisionCreate, nil, &saved)
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return saved, nil
+}
+
+func (d Repository) GetBookFromDatebase(id uint, ctx context.Context) (domain.Book, error) {
+	query := "SELECT " + bookColumns + ", " + bookAuthorsColumn + " FROM books WHERE id = ?1 AND deleted_at IS NULL"
+	return d.getBook(ctx, query, id)
+}
+
+// GetBookByIsbn expects the normalized 13-digit ISBN.
+func (d Repository) GetBookByIsbn(ctx context.Context, isbn string) (domain.Book, error) {
+	query := "SELECT " + bookColumns + ", " + bookAuthorsColumn + " FROM books WHERE isbn = ?1 AND deleted_at IS NULL"
+	return d.getBook(ctx, query, isbn)
+}
+
+func (d Repository) getBook(ctx context.Context, query string, key any) (domain.Book, error) {
+	var book domain.Book
+	err := scanBookWithAuthors(d.db.QueryRowContext(ctx, query, key), &book)
+	if err != nil {
+		return domain.Book{}, dbError(err, "book", key)
+	}
+	return book, nil
+}
+
+// DeleteBookFromDatebase moves the book to the trash, only in the given
+// version unless version is 0. A missing or already deleted book is errs.NotFound.
+func (d Repository) DeleteBookFromDatebase(id uint, version int, ctx context.Context) error {
+	return d.deleteBook(ctx, int(id), version, 0)
+}
+
+// UpdateBookFromDatabase changes only the given fields (see domain.BookFields
+// and domain.BookAuthorsField) and returns the book as it is after the update.
+// A non-zero book.Version must match the stored one, otherwise the result is
+// errs.Aborted.
+func (d Repository) UpdateBookFromDatabase(book domain.Book, fields []string, ctx context.Context) (domain.Book, error) {
+	return d.updateBook(ctx, book, fields, 0)
+}
+
+// UpdateBookOwnedBy updates the book only if it belongs to userID.
+// It returns errs.NotFound for a missing book and errs.PermissionDenied for someone else's one.
+func (d Repository) UpdateBookOwnedBy(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	return d.updateBook(ctx, book, fields, userID)
+}
+
+func (d Repository) updateBook(ctx context.Context, book domain.Book, fields []string, userID int) (domain.Book, error) {
+	var updated domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		before, err := readBook(ctx, tx, book.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, book.Version); err != nil {
+			return err
+		}
+		updated, err = updateBookFields(ctx, tx, book, fields)
+		if err != nil {
+			return err
+		}
+		updated.Authors = before.Authors
+		if slices.Contains(fields, domain.BookAuthorsField) {
+			if err := setBookAuthors(ctx, tx, book.ID, book.Authors); err != nil {
+				return err
+			}
+			if updated, err = readBook(ctx, tx, book.ID); err != nil {
+				return err
+			}
+		}
+		return recordRevision(ctx, tx, domain.RevisionUpdate, &before, &updated)
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return updated, nil
+}
+
+// updateBookFields writes the fields of the book and returns the new row
+// without authors.
+func updateBookFields(ctx context.Context, tx *sql.Tx, book domain.Book, fields []string) (domain.Book, error) {
+	set, args, err := bookUpdateSet(book, fields)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	args = append(args, book.ID)
+	query := fmt.Sprintf("UPDATE books SET %s WHERE id = ?%d RETURNING %s", set, len(args), bookColumns)
+
+	var updated domain.Book
+	err = scanBook(tx.QueryRowContext(ctx, query, args...), &updated)
+	if err != nil {
+		return domain.Book{}, dbError(err, "book", bookKey(book))
+	}
+	return updated, nil
+}
+
+// bookUpdateSet builds the SET list for the fields, numbering placeholders from ?1.
+// The version is bumped even when only the authors change.
+func bookUpdateSet(book domain.Book, fields []string) (string, []any, error) {
+	var set []string
+	var args []any
+	for _, field := range fields {
+		column, value, expr := field, any(nil), "%s = ?%d"
+		switch field {
+		case "title":
+			value = book.Title
+		case "year":
+			column, value = "year_book", book.Year
+		case "isbn":
+			value, expr = book.ISBN, "%s = NULLIF(?%d,'')"
+		case "edition":
+			value = book.Edition
+		case "publisher":
+			value = book.Publisher
+		case "cover_url":
+			value = book.CoverURL
+		case domain.BookAuthorsField:
+			continue // см. setBookAuthors
+		default:
+			return "", nil, errs.InvalidArgument("invalid update_mask",
+				errs.FieldViolation{Field: "update_mask", Description: "unknown field " + field})
+		}
+		args = append(args, value)
+		set = append(set, fmt.Sprintf(expr, column, len(args)))
+	}
+	set = append(set, "version = version + 1")
+	return strings.Join(set, ", "), args, nil
+}
+
+// DeleteBookOwnedBy moves the book to the trash only if it belongs to userID.
+func (d Repository) DeleteBookOwnedBy(ctx context.Context, id uint, version int, userID int) error {
+	return d.deleteBook(ctx, int(id), version, userID)
+}
+
+func (d Repository) deleteBook(ctx context.Context, id, version, userID int) error {
+	return d.transact(ctx, nil, func(tx *sql.Tx) error {
+		before, err := readBook(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkBookWrite(before, userID, version); err != nil {
+			return err
+		}
+		query := "UPDATE books SET deleted_at = ?2, version = version + 1 WHERE id = ?1 RETURNING " + bookColumns
+		var deleted domain.Book
+		if err := scanBook(tx.QueryRowContext(ctx, query, id, timestamp(time.Now())), &deleted); err != nil {
+			return err
+		}
+		deleted.Authors = before.Authors
+		return recordRevision(ctx, tx, domain.RevisionDelete, &before, &deleted)
+	})
+}
+
+// readBook reads the book with its authors, trash included. Unlike Postgres
+// there is no row lock to take: the transaction holds the write lock of the
+// whole database since BEGIN (see Open).
+func readBook(ctx context.Context, tx *sql.Tx, id int) (domain.Book, error) {
+	query := "SELECT " + bookColumns + ", " + bookAuthorsColumn + " FROM books WHERE id = ?1"
+	var book domain.Book
+	err := scanBookWithAuthors(tx.QueryRowContext(ctx, query, id), &book)
+	if err != nil {
+		return domain.Book{}, dbError(err, "book", id)
+	}
+	return book, nil
+}
+
+// checkBookWrite tells why the book cannot be written: it is in the trash,
+// belongs to someone else (unless userID is 0) or its version has changed
+// (unless version is 0).
+func checkBookWrite(book domain.Book, userID, version int) error {
+	if book.DeletedAt != nil {
+		return errs.NotFound("book", book.ID)
+	}
+	if userID != 0 && book.UserID != userID {
+		return errs.PermissionDenied("book", book.ID, "book belongs to another user")
+	}
+	if version != 0 && book.Version != version {
+		return errs.Aborted("book", book.ID, fmt.Sprintf("book was changed: version is %d, expected %d", book.Version, version))
+	}
+	return nil
+}
+
+var bookOrderColumns = map[string]string{
+	"":      "id",
+	"id":    "id",
+	"title": "title",
+	"year":  "year_book",
+}
+
+// AllBooksFromDatabase returns one page of books using keyset pagination:
+// the next page starts right after query.After in the requested order.
+func (d Repository) AllBooksFromDatabase(ctx context.Context, query domain.BookQuery) ([]domain.Book, error) {
+	var books []domain.Book
+	err := d.StreamBooks(ctx, query, func(book domain.Book) error {
+		books = append(books, book)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// StreamBooks calls fn for every book of the query while the rows are being
+// read, authors included. The statement sees one snapshot of the database;
+// in WAL mode it does not hold up writers even if fn is slow.
+func (d Repository) StreamBooks(ctx context.Context, query domain.BookQuery, fn func(domain.Book) error) error {
+	sqlQuery, args, err := bookListQuery("SELECT "+bookColumns+", "+bookAuthorsColumn+" FROM books", query)
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var book domain.Book
+		if err := scanBookWithAuthors(rows, &book); err != nil {
+			return err
+		}
+		if err := fn(book); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// bookListQuery adds the filter, the keyset condition, the order and the
+// limit of the query to selectFrom.
+func bookListQuery(selectFrom string, query domain.BookQuery) (string, []any, error) {
+	column, ok := bookOrderColumns[query.OrderBy]
+	if !ok {
+		return "", nil, errs.InvalidArgument("invalid order_by", errs.FieldViolation{Field: "order_by", Description: "unknown field " + query.OrderBy})
+	}
+	where, args := bookFilterWhere(query.Filter)
+
+	direction, compare := "ASC", ">"
+	if query.Desc {
+		direction, compare = "DESC", "<"
+	}
+	if query.After != nil {
+		if column == "id" {
+			args = append(args, query.After.ID)
+			where = append(where, fmt.Sprintf("id %s ?%d", compare, len(args)))
+		} else {
+			var value any = query.After.Title
+			if column == "year_book" {
+				value = query.After.Year
+			}
+			args = append(args, value, query.After.ID)
+			where = append(where, fmt.Sprintf("(%s, id) %s (?%d, ?%d)", column, compare, len(args)-1, len(args)))
+		}
+	}
+
+	sqlQuery := selectFrom + whereClause(where)
+	if column == "id" {
+		sqlQuery += " ORDER BY id " + direction
+	} else {
+		sqlQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	}
+	if query.Limit > 0 {
+		args = append(args, query.Limit)
+		sqlQuery += fmt.Sprintf(" LIMIT ?%d", len(args))
+	}
+	return sqlQuery, args, nil
+}
+
+func (d Repository) CountBooks(ctx context.Context, filter domain.BookFilter) (int, error) {
+	where, args := bookFilterWhere(filter)
+	query := "SELECT count(*) FROM books" + whereClause(where)
+
+	var count int
+	err := d.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// bookFilterWhere returns the WHERE conditions for the filter, numbering
+// placeholders from ?1.
+func bookFilterWhere(filter domain.BookFilter) ([]string, []any) {
+	where := []string{"deleted_at IS NULL"}
+	if filter.Deleted {
+		where[0] = "deleted_at IS NOT NULL"
+	}
+	var args []any
+	if filter.TitleContains != "" {
+		// LIKE в SQLite не различает регистр только у латиницы.
+		args = append(args, "%"+likeEscaper.Replace(filter.TitleContains)+"%")
+		where = append(where, fmt.Sprintf(`title LIKE ?%d ESCAPE '\'`, len(args)))
+	}
+	if filter.YearMin != nil {
+		args = append(args, *filter.YearMin)
+		where = append(where, fmt.Sprintf("year_book >= ?%d", len(args)))
+	}
+	if filter.YearMax != nil {
+		args = append(args, *filter.YearMax)
+		where = append(where, fmt.Sprintf("year_book <= ?%d", len(args)))
+	}
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		where = append(where, fmt.Sprintf("user_id = ?%d", len(args)))
+	}
+	return where, args
+}
+
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(where, " AND ")
+}
+
+// likeEscaper escapes the LIKE wildcards so they match literally with ESCAPE '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (d Repository) SaveUserToDatabase(ctx context.Context, user domain.User) (domain.User, error) {
+	query := "INSERT INTO users (email, password) VALUES(?1,?2) RETURNING user_id, email, password, is_admin"
+	err := d.db.QueryRowContext(ctx, query, user.Email, user.Password).Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
+	if err != nil {
+		return domain.User{}, dbError(err, "user", user.Email)
+	}
+	return user, nil
+}
+func (d Repository) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
+	var user domain.User
+	query := "SELECT user_id, email, password, is_admin FROM users WHERE email = ?1"
+	err := d.db.QueryRowContext(ctx, query, email).Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
+	if err != nil {
+		return domain.User{}, dbError(err, "user", email)
+	}
+	return user, nil
+}
+func (d Repository) UpdateUserPassword(ctx context.Context, userID int, password string) error {
+	query := "UPDATE users SET password = ?1 WHERE user_id = ?2"
+	_, err := d.db.ExecContext(ctx, query, password, userID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (d Repository) SaveSessionToDatabase(ctx context.Context, session domain.Session) error {
+	query := "INSERT INTO sessions (user_id, token, ip, user_agent, created_at, last_seen_at) VALUES(?1,?2,?3,?4,?5,?5)"
+	_, err := d.db.ExecContext(ctx, query, session.UserID, session.Token, session.IP, session.UserAgent, timestamp(time.Now()))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// sessionAlive is the WHERE condition for sessions (aliased as s) that have not expired yet.
+// absolute and idle are placeholders for the arguments from sessionCutoffs.
+func sessionAlive(absolute, idle string) string {
+	return fmt.Sprintf(`(%[1]s = '' OR s.created_at > %[1]s)
+		AND (%[2]s = '' OR s.last_seen_at > %[2]s)`, absolute, idle)
+}
+
+// sessionCutoffs returns the oldest created_at and last_seen_at a live session
+// may have at now, an empty string when the TTL is 0.
+func (d Repository) sessionCutoffs(now time.Time) (string, string) {
+	cutoff := func(ttl time.Duration) string {
+		if ttl == 0 {
+			return ""
+		}
+		return timestamp(now.Add(-ttl))
+	}
+	return cutoff(d.sessionTTL.Absolute), cutoff(d.sessionTTL.Idle)
+}
+
+// GetUserByToken returns the owner of a live session and marks the session as seen.
+// Expired and unknown tokens give errs.NotFound.
+func (d Repository) GetUserByToken(ctx context.Context, token string) (domain.User, error) {
+	now := time.Now()
+	absolute, idle := d.sessionCutoffs(now)
+	// RETURNING в SQLite не видит таблиц из FROM, поэтому пользователь отдельным запросом.
+	var user domain.User
+	query := `UPDATE sessions AS s SET last_seen_at = ?1
+		WHERE s.token = ?2 AND ` + sessionAlive("?3", "?4") + `
+		RETURNING user_id`
+	err := d.db.QueryRowContext(ctx, query, timestamp(now), token, absolute, idle).Scan(&user.ID)
+	if err != nil {
+		return domain.User{}, dbError(err, "session", "")
+	}
+	query = "SELECT user_id, email, password, is_admin FROM users WHERE user_id = ?1"
+	err = d.db.QueryRowContext(ctx, query, user.ID).Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
+	if err != nil {
+		return domain.User{}, dbError(err, "session", "")
+	}
+	return user, nil
+}
+
+func (d Repository) ListUserSessions(ctx context.Context, userID int) ([]domain.Session, error) {
+	var sessions []domain.Session
+	absolute, idle := d.sessionCutoffs(time.Now())
+	query := `SELECT s.id, s.user_id, s.token, s.ip, s.user_agent, s.created_at, s.last_seen_at
+		FROM sessions s
+		WHERE s.user_id = ?1 AND ` + sessionAlive("?2", "?3") + `
+		ORDER BY s.last_seen_at DESC`
+	rows, err := d.db.QueryContext(ctx, query, userID, absolute, idle)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var session domain.Session
+		err = rows.Scan(&session.ID, &session.UserID, &session.Token, &session.IP, &session.UserAgent, &session.CreatedAt, &session.LastSeenAt)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+func (d Repository) DeleteSessionByToken(ctx context.Context, token string) error {
+	query := "DELETE FROM sessions WHERE token = ?1"
+	_, err := d.db.ExecContext(ctx, query, token)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteUserSession deletes one session of the user, errs.NotFound if there is no such session.
+func (d Repository) DeleteUserSession(ctx context.Context, userID int, sessionID int) error {
+	query := "DELETE FROM sessions WHERE id = ?1 AND user_id = ?2"
+	res, err := d.db.ExecContext(ctx, query, sessionID, userID)
+	if err != nil {
+		return err
+	}
+	return affectedOrNotFound(res, "session", sessionID)
+}
+
+// DeleteUserSessions deletes every session of the user except the one with exceptToken.
+func (d Repository) DeleteUserSessions(ctx context.Context, userID int, exceptToken string) (int64, error) {
+	query := "DELETE FROM sessions WHERE user_id = ?1 AND token <> ?2"
+	res, err := d.db.ExecContext(ctx, query, userID, exceptToken)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (d Repository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	absolute, idle := d.sessionCutoffs(time.Now())
+	query := "DELETE FROM sessions AS s WHERE NOT (" + sessionAlive("?1", "?2") + ")"
+	res, err := d.db.ExecContext(ctx, query, absolute, idle)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,36 @@
+package sqlite_test
+
+import (
+	"bookserver_git/internal/api"
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/repotest"
+	"bookserver_git/internal/sqlite"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+// TestRepository runs the suite on a new database file for every subtest.
+func TestRepository(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) api.Repository {
+		path := filepath.Join(t.TempDir(), "books.db")
+		migrator, err := migrate.New("file://../../migrations_sqlite", "sqlite://"+path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer migrator.Close()
+		if err := migrator.Up(); err != nil {
+			t.Fatal(err)
+		}
+
+		conn, err := sqlite.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return sqlite.NewRepository(conn, domain.SessionTTL{})
+	})
+}
@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/domain"
+	"bookserver_git/internal/errs"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// UndeleteBook takes the book out of the trash. It is errs.AlreadyExists when
+// an equal book was added after the deletion.
+func (d Repository) UndeleteBook(ctx context.Context, id int) (domain.Book, error) {
+	return d.undeleteBook(ctx, id, 0)
+}
+
+// UndeleteBookOwnedBy takes the book out of the trash only if it belongs to userID.
+func (d Repository) UndeleteBookOwnedBy(ctx context.Context, id int, userID int) (domain.Book, error) {
+	return d.undeleteBook(ctx, id, userID)
+}
+
+func (d Repository) undeleteBook(ctx context.Context, id, userID int) (domain.Book, error) {
+	var book domain.Book
+	err := d.transact(ctx, nil, func(tx *sql.Tx) error {
+		before, err := readBook(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if before.DeletedAt == nil {
+			return errs.NotFound("book", id)
+		}
+		if userID != 0 && before.UserID != userID {
+			return errs.PermissionDenied("book", id, "book belongs to another user")
+		}
+
+		query := "UPDATE books SET deleted_at = NULL, version = version + 1 WHERE id = ?1 RETURNING " + bookColumns
+		if err := scanBook(tx.QueryRowContext(ctx, query, id), &book); err != nil {
+			return dbError(err, "book", bookKey(before))
+		}
+		book.Authors = before.Authors
+		return recordRevision(ctx, tx, domain.RevisionUndelete, &before, &book)
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return book, nil
+}
+
+// PurgeDeletedBooks removes for good the books that have been in the trash
+// longer than retention.
+func (d Repository) PurgeDeletedBooks(ctx context.Context, retention time.Duration) (int64, error) {
+	query := "DELETE FROM books WHERE deleted_at < ?1"
+	res, err := d.db.ExecContext(ctx, query, timestamp(time.Now().Add(-retention)))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
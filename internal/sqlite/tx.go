@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"bookserver_git/internal/api"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// querier is what Repository needs from *sql.DB and *sql.Tx alike.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// txAttempts is how many times a transaction is run before its busy error is
+// returned to the caller.
+const txAttempts = 3
+
+// WithTx runs fn in one transaction. SQLite runs write transactions one at a
+// time, so they are serializable without saying. The repository passed to fn
+// does all its work in that transaction, methods that need a transaction of
+// their own join it. If the database stays locked longer than busy_timeout
+// the whole fn is run again, so fn must not have effects outside the repository.
+func (d Repository) WithTx(ctx context.Context, fn func(api.Repository) error) error {
+	return d.transact(ctx, nil, func(tx *sql.Tx) error {
+		inTx := d
+		inTx.db, inTx.tx = tx, tx
+		return fn(inTx)
+	})
+}
+
+// transact runs fn in the transaction of WithTx or, outside of it, in a new
+// transaction that is retried like in WithTx.
+func (d Repository) transact(ctx context.Context, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	if d.tx != nil {
+		return fn(d.tx)
+	}
+	for attempt := 1; ; attempt++ {
+		err := d.transactOnce(ctx, opts, fn)
+		if err == nil || attempt == txAttempts || !retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * 10 * time.Millisecond):
+		}
+	}
+}
+
+func (d Repository) transactOnce(ctx context.Context, opts *sql.TxOptions, fn func(tx *sql.Tx) error) error {
+	tx, err := d.conn.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// retryable reports whether the transaction failed only because the database
+// was locked by other connections and may succeed if run again.
+func retryable(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	code := sqliteErr.Code() & 0xff // основной код без расширенного
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Every calls fn once per interval until ctx is done.
+// Errors are logged and do not stop the loop, except those of a run cut
+// short by ctx being done.
+func Every(ctx context.Context, log *slog.Logger, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	if interval <= 0 {
+		log.Warn("worker disabled", slog.String("worker", name))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil && ctx.Err() == nil {
+				log.Error("worker failed", slog.String("worker", name), slog.Any("error", err))
+			}
+		}
+	}
+}
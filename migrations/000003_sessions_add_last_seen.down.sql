@@ -0,0 +1,3 @@
+DROP INDEX sessions_user_id_idx;
+DROP INDEX sessions_token_key;
+ALTER TABLE sessions DROP COLUMN last_seen_at;
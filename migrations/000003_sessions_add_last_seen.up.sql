@@ -0,0 +1,3 @@
+ALTER TABLE sessions ADD COLUMN last_seen_at timestamp NOT NULL DEFAULT now();
+CREATE UNIQUE INDEX sessions_token_key ON sessions (token);
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
@@ -0,0 +1,3 @@
+DROP INDEX books_title_trgm_idx;
+DROP INDEX books_search_idx;
+ALTER TABLE books DROP COLUMN search;
@@ -0,0 +1,7 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE books ADD COLUMN search tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', title)) STORED;
+
+CREATE INDEX books_search_idx ON books USING GIN (search);
+CREATE INDEX books_title_trgm_idx ON books USING GIN (title gin_trgm_ops);
@@ -0,0 +1,13 @@
+CREATE TABLE authors(
+id serial PRIMARY KEY,
+name text NOT NULL
+);
+CREATE UNIQUE INDEX authors_name_key ON authors (lower(name));
+
+CREATE TABLE book_authors(
+book_id int NOT NULL REFERENCES books ON DELETE CASCADE,
+author_id int NOT NULL REFERENCES authors ON DELETE CASCADE,
+position int NOT NULL DEFAULT 0,
+PRIMARY KEY (book_id, author_id)
+);
+CREATE INDEX book_authors_author_id_idx ON book_authors (author_id);
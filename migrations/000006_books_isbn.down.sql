@@ -0,0 +1,7 @@
+DROP INDEX books_title_edition_key;
+DROP INDEX books_isbn_key;
+
+ALTER TABLE books DROP COLUMN edition;
+ALTER TABLE books DROP COLUMN isbn;
+
+ALTER TABLE books ADD CONSTRAINT books_title_key UNIQUE (title);
@@ -0,0 +1,8 @@
+ALTER TABLE books DROP CONSTRAINT books_title_key;
+
+ALTER TABLE books ADD COLUMN isbn varchar(13);
+ALTER TABLE books ADD COLUMN edition text NOT NULL DEFAULT '';
+
+-- Книга с ISBN уникальна по ISBN, без ISBN — по названию и изданию.
+CREATE UNIQUE INDEX books_isbn_key ON books (isbn) WHERE isbn IS NOT NULL;
+CREATE UNIQUE INDEX books_title_edition_key ON books (title, edition) WHERE isbn IS NULL;
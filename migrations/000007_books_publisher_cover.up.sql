@@ -0,0 +1,2 @@
+ALTER TABLE books ADD COLUMN publisher text NOT NULL DEFAULT '';
+ALTER TABLE books ADD COLUMN cover_url text NOT NULL DEFAULT '';
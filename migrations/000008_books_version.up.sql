@@ -0,0 +1,2 @@
+-- Растёт на каждой записи книги, по нему считается ETag.
+ALTER TABLE books ADD COLUMN version int NOT NULL DEFAULT 1;
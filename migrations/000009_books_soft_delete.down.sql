@@ -0,0 +1,9 @@
+DELETE FROM books WHERE deleted_at IS NOT NULL;
+
+DROP INDEX books_deleted_at_idx;
+DROP INDEX books_isbn_key;
+DROP INDEX books_title_edition_key;
+CREATE UNIQUE INDEX books_isbn_key ON books (isbn) WHERE isbn IS NOT NULL;
+CREATE UNIQUE INDEX books_title_edition_key ON books (title, edition) WHERE isbn IS NULL;
+
+ALTER TABLE books DROP COLUMN deleted_at;
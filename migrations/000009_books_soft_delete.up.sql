@@ -0,0 +1,10 @@
+-- Удалённая книга лежит в корзине, пока её не вычистит purge.
+ALTER TABLE books ADD COLUMN deleted_at timestamptz;
+
+-- Книга в корзине не мешает завести такую же заново.
+DROP INDEX books_isbn_key;
+DROP INDEX books_title_edition_key;
+CREATE UNIQUE INDEX books_isbn_key ON books (isbn) WHERE isbn IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX books_title_edition_key ON books (title, edition) WHERE isbn IS NULL AND deleted_at IS NULL;
+
+CREATE INDEX books_deleted_at_idx ON books (deleted_at) WHERE deleted_at IS NOT NULL;
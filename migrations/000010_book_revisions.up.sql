@@ -0,0 +1,12 @@
+-- История изменений книг. Ссылки на books нет: история остаётся и после purge.
+CREATE TABLE book_revisions(
+id bigserial PRIMARY KEY,
+book_id int NOT NULL,
+version int NOT NULL,
+action text NOT NULL,
+actor_id int REFERENCES users ON DELETE SET NULL,
+changed_at timestamptz NOT NULL DEFAULT now(),
+before jsonb,
+after jsonb
+);
+CREATE INDEX book_revisions_book_id_idx ON book_revisions (book_id, id);
@@ -0,0 +1,7 @@
+DROP TABLE book_revisions;
+DROP TABLE book_authors;
+DROP TABLE authors;
+DROP TABLE books_search;
+DROP TABLE books;
+DROP TABLE sessions;
+DROP TABLE users;
@@ -0,0 +1,77 @@
+-- Та же схема, что у migrations/ по 000010 включительно, в диалекте SQLite.
+-- Время хранится текстом в UTC фиксированной ширины (см. internal/sqlite),
+-- поэтому сравнивается как строка.
+CREATE TABLE users(
+user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+email text UNIQUE NOT NULL,
+password text NOT NULL,
+is_admin boolean NOT NULL DEFAULT false
+);
+
+CREATE TABLE sessions(
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id int NOT NULL REFERENCES users,
+token text NOT NULL,
+ip text NOT NULL,
+user_agent text NOT NULL,
+created_at datetime NOT NULL,
+last_seen_at datetime NOT NULL
+);
+CREATE UNIQUE INDEX sessions_token_key ON sessions (token);
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+
+-- AUTOINCREMENT, чтобы id книги после purge не достался новой: история остаётся.
+CREATE TABLE books(
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+title varchar(50) NOT NULL,
+year_book INTEGER NOT NULL,
+user_id int NOT NULL,
+isbn varchar(13),
+edition text NOT NULL DEFAULT '',
+publisher text NOT NULL DEFAULT '',
+cover_url text NOT NULL DEFAULT '',
+version int NOT NULL DEFAULT 1,
+deleted_at datetime
+);
+CREATE UNIQUE INDEX books_isbn_key ON books (isbn) WHERE isbn IS NOT NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX books_title_edition_key ON books (title, edition) WHERE isbn IS NULL AND deleted_at IS NULL;
+CREATE INDEX books_deleted_at_idx ON books (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Поиск по названию вместо tsvector и pg_trgm.
+CREATE VIRTUAL TABLE books_search USING fts5(title, content='books', content_rowid='id');
+CREATE TRIGGER books_search_insert AFTER INSERT ON books BEGIN
+    INSERT INTO books_search (rowid, title) VALUES (new.id, new.title);
+END;
+CREATE TRIGGER books_search_delete AFTER DELETE ON books BEGIN
+    INSERT INTO books_search (books_search, rowid, title) VALUES ('delete', old.id, old.title);
+END;
+CREATE TRIGGER books_search_update AFTER UPDATE OF title ON books BEGIN
+    INSERT INTO books_search (books_search, rowid, title) VALUES ('delete', old.id, old.title);
+    INSERT INTO books_search (rowid, title) VALUES (new.id, new.title);
+END;
+
+CREATE TABLE authors(
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+name text NOT NULL
+);
+CREATE UNIQUE INDEX authors_name_key ON authors (lower(name));
+
+CREATE TABLE book_authors(
+book_id int NOT NULL REFERENCES books ON DELETE CASCADE,
+author_id int NOT NULL REFERENCES authors ON DELETE CASCADE,
+position int NOT NULL DEFAULT 0,
+PRIMARY KEY (book_id, author_id)
+);
+CREATE INDEX book_authors_author_id_idx ON book_authors (author_id);
+
+CREATE TABLE book_revisions(
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+book_id int NOT NULL,
+version int NOT NULL,
+action text NOT NULL,
+actor_id int REFERENCES users ON DELETE SET NULL,
+changed_at datetime NOT NULL,
+before text,
+after text
+);
+CREATE INDEX book_revisions_book_id_idx ON book_revisions (book_id, id);